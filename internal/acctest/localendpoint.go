@@ -0,0 +1,128 @@
+package acctest
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// LocalEndpoint returns the URL of the local stand-in for AWS from the environment, if any.
+//
+// In local endpoint mode, all providers created for acceptance testing send
+// AWS API requests for every service to the local endpoint, e.g. LocalStack or Moto.
+func LocalEndpoint() string {
+	return os.Getenv(conns.EnvVarAccLocalEndpoint)
+}
+
+// localEndpointServices returns the provider package names of the services supported by the local endpoint.
+// A nil result means all services are supported.
+func localEndpointServices() (map[string]bool, error) {
+	v := os.Getenv(conns.EnvVarAccLocalEndpointServices)
+
+	if v == "" {
+		return nil, nil
+	}
+
+	services := make(map[string]bool)
+
+	for _, alias := range strings.Split(v, ",") {
+		alias = strings.ToLower(strings.TrimSpace(alias))
+
+		if alias == "" {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(alias)
+		if err != nil {
+			return nil, err
+		}
+
+		services[service] = true
+	}
+
+	return services, nil
+}
+
+// configureLocalEndpoint overrides the provider configuration to send all AWS API requests to the local endpoint.
+func configureLocalEndpoint(d *schema.ResourceData, endpoint string) error {
+	endpoints := make(map[string]interface{})
+	for _, alias := range names.Aliases() {
+		endpoints[alias] = endpoint
+	}
+
+	settings := map[string]interface{}{
+		"endpoints":                   []interface{}{endpoints},
+		"s3_use_path_style":           true,
+		"skip_credentials_validation": true,
+		"skip_get_ec2_platforms":      true,
+		"skip_metadata_api_check":     "true",
+		"skip_region_validation":      true,
+	}
+
+	for k, v := range settings {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("setting %s: %w", k, err)
+		}
+	}
+
+	return nil
+}
+
+// PreCheckLocalEndpointServices skips the test in local endpoint mode if any of the
+// services required by the test, e.g. names.SQS, is not supported by the local endpoint.
+//
+// Outside of local endpoint mode, this PreCheck function has no effect.
+func PreCheckLocalEndpointServices(t *testing.T, services ...string) {
+	if LocalEndpoint() == "" {
+		return
+	}
+
+	supported, err := localEndpointServices()
+
+	if err != nil {
+		t.Fatalf("error reading %s: %s", conns.EnvVarAccLocalEndpointServices, err)
+	}
+
+	if supported == nil {
+		return
+	}
+
+	for _, service := range services {
+		if !supported[service] {
+			t.Skipf("skipping acceptance test: service (%s) not supported by local endpoint (%s)", service, LocalEndpoint())
+		}
+	}
+}
+
+// preCheckLocalEndpointEndpointsID skips the test in local endpoint mode if none of the
+// services with the endpoint ID, e.g. sqs.EndpointsID, is supported by the local endpoint.
+//
+// Outside of local endpoint mode, this PreCheck function has no effect.
+func preCheckLocalEndpointEndpointsID(t *testing.T, endpointsID string) {
+	if LocalEndpoint() == "" {
+		return
+	}
+
+	supported, err := localEndpointServices()
+
+	if err != nil {
+		t.Fatalf("error reading %s: %s", conns.EnvVarAccLocalEndpointServices, err)
+	}
+
+	if supported == nil {
+		return
+	}
+
+	for _, service := range names.ProviderPackagesForEndpointsID(endpointsID) {
+		if supported[service] {
+			return
+		}
+	}
+
+	t.Skipf("skipping acceptance test: endpoint ID (%s) not supported by local endpoint (%s)", endpointsID, LocalEndpoint())
+}
@@ -0,0 +1,137 @@
+package acctest
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestConfigureLocalEndpoint(t *testing.T) {
+	endpoint := "http://localhost:4566"
+
+	d := schema.TestResourceDataRaw(t, provider.Provider().Schema, map[string]interface{}{
+		"region": "us-west-2", //lintignore:AWSAT003
+	})
+
+	if err := configureLocalEndpoint(d, endpoint); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, k := range []string{"s3_use_path_style", "skip_credentials_validation", "skip_get_ec2_platforms", "skip_region_validation"} {
+		if !d.Get(k).(bool) {
+			t.Errorf("expected %s to be true", k)
+		}
+	}
+
+	if got := d.Get("skip_metadata_api_check").(string); got != "true" {
+		t.Errorf("got skip_metadata_api_check %q, expected %q", got, "true")
+	}
+
+	endpoints := d.Get("endpoints").(*schema.Set).List()
+	if len(endpoints) != 1 {
+		t.Fatalf("got %d endpoints blocks, expected 1", len(endpoints))
+	}
+
+	for _, alias := range []string{names.EC2, names.S3, names.SQS, names.STS} {
+		if got := endpoints[0].(map[string]interface{})[alias]; got != endpoint {
+			t.Errorf("got %s endpoint %q, expected %q", alias, got, endpoint)
+		}
+	}
+}
+
+func TestLocalEndpointServices(t *testing.T) {
+	t.Setenv(conns.EnvVarAccLocalEndpointServices, "")
+
+	services, err := localEndpointServices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if services != nil {
+		t.Errorf("got %v, expected all services", services)
+	}
+
+	t.Setenv(conns.EnvVarAccLocalEndpointServices, "s3, SQS,lambda,")
+
+	services, err = localEndpointServices()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(services) != 3 || !services[names.S3] || !services[names.SQS] || !services[names.Lambda] {
+		t.Errorf("got %v, expected s3, sqs and lambda", services)
+	}
+
+	t.Setenv(conns.EnvVarAccLocalEndpointServices, "s3,notaservice")
+
+	if _, err := localEndpointServices(); err == nil {
+		t.Error("expected error for unknown service")
+	}
+}
+
+func TestPreCheckLocalEndpointServices(t *testing.T) {
+	t.Setenv(conns.EnvVarAccLocalEndpoint, "http://localhost:4566")
+	t.Setenv(conns.EnvVarAccLocalEndpointServices, "s3,sqs")
+
+	t.Run("supported", func(t *testing.T) {
+		PreCheckLocalEndpointServices(t, names.S3, names.SQS)
+
+		if t.Skipped() {
+			t.Error("expected test not to be skipped")
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		defer func() {
+			if !t.Skipped() {
+				t.Error("expected test to be skipped")
+			}
+		}()
+
+		PreCheckLocalEndpointServices(t, names.S3, names.EC2)
+	})
+}
+
+func TestPreCheckPartitionHasServiceLocalEndpoint(t *testing.T) {
+	t.Setenv(conns.EnvVarAccLocalEndpoint, "http://localhost:4566")
+	t.Setenv(conns.EnvVarAccLocalEndpointServices, "cloudwatch,sqs")
+
+	t.Run("supported", func(t *testing.T) {
+		PreCheckPartitionHasService("sqs", t)
+
+		if t.Skipped() {
+			t.Error("expected test not to be skipped")
+		}
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		defer func() {
+			if !t.Skipped() {
+				t.Error("expected test to be skipped")
+			}
+		}()
+
+		PreCheckPartitionHasService("ec2", t)
+	})
+
+	t.Run("endpoint ID not a service alias", func(t *testing.T) {
+		PreCheckPartitionHasService("monitoring", t)
+
+		if t.Skipped() {
+			t.Error("expected test not to be skipped")
+		}
+	})
+
+	t.Run("shared endpoint ID", func(t *testing.T) {
+		t.Setenv(conns.EnvVarAccLocalEndpointServices, "elbv2")
+
+		PreCheckPartitionHasService("elasticloadbalancing", t)
+
+		if t.Skipped() {
+			t.Error("expected test not to be skipped")
+		}
+	})
+}
@@ -3,6 +3,7 @@ package acctest
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -315,6 +316,25 @@ func TestAccProvider_IgnoreTagsKeyPrefixes_multiple(t *testing.T) {
 	})
 }
 
+func TestAccProvider_IgnoreTagsKeyRegexes_multiple(t *testing.T) {
+	var providers []*schema.Provider
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { PreCheck(t) },
+		ErrorCheck:        ErrorCheck(t),
+		ProviderFactories: FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_ignoreTagsKeyRegexes2(`^kubernetes\.io/cluster/`, `^scanner-[0-9]+$`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIgnoreTagsKeyRegexes(&providers, []string{`^kubernetes\.io/cluster/`, `^scanner-[0-9]+$`}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccProvider_IgnoreTagsKeys_none(t *testing.T) {
 	var providers []*schema.Provider
 
@@ -522,6 +542,38 @@ func TestAccProvider_AssumeRole_empty(t *testing.T) {
 	})
 }
 
+func TestAccProvider_DefaultTimeouts_create(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { PreCheck(t) },
+		ErrorCheck:               ErrorCheck(t),
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_defaultTimeouts("1s", ""),
+				ExpectError: regexp.MustCompile(`timeout while waiting for state to become 'available'`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_DefaultTimeouts_resourceOverride(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { PreCheck(t) },
+		ErrorCheck:               ErrorCheck(t),
+		ProtoV5ProviderFactories: ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_defaultTimeouts("1s", "10m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("aws_ec2_transit_gateway.test", "state", "available"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPartition(providers *[]*schema.Provider, expectedPartition string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -693,6 +745,52 @@ func testAccCheckIgnoreTagsKeyPrefixes(providers *[]*schema.Provider, expectedKe
 	}
 }
 
+func testAccCheckIgnoreTagsKeyRegexes(providers *[]*schema.Provider, expectedKeyRegexes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if providers == nil {
+			return fmt.Errorf("no providers initialized")
+		}
+
+		for _, provo := range *providers {
+			if provo == nil || provo.Meta() == nil || provo.Meta().(*conns.AWSClient) == nil {
+				continue
+			}
+
+			providerClient := provo.Meta().(*conns.AWSClient)
+			ignoreTagsConfig := providerClient.IgnoreTagsConfig
+
+			var actualKeyRegexes []string
+
+			if ignoreTagsConfig != nil {
+				for _, v := range ignoreTagsConfig.KeyRegexes {
+					actualKeyRegexes = append(actualKeyRegexes, v.String())
+				}
+			}
+
+			if len(actualKeyRegexes) != len(expectedKeyRegexes) {
+				return fmt.Errorf("expected key_regexes (%d) length, got: %d", len(expectedKeyRegexes), len(actualKeyRegexes))
+			}
+
+			for _, expectedElement := range expectedKeyRegexes {
+				var found bool
+
+				for _, actualElement := range actualKeyRegexes {
+					if actualElement == expectedElement {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					return fmt.Errorf("expected key_regexes element, but was missing: %s", expectedElement)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckIgnoreTagsKeys(providers *[]*schema.Provider, expectedKeys []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if providers == nil {
@@ -1105,6 +1203,24 @@ provider "aws" {
 `, tagPrefix1, tagPrefix2))
 }
 
+func testAccProviderConfig_ignoreTagsKeyRegexes2(tagRegex1, tagRegex2 string) string {
+	//lintignore:AT004
+	return ConfigCompose(
+		testAccProviderConfigBase,
+		fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    key_regexes = [%[1]q, %[2]q]
+  }
+
+  skip_credentials_validation = true
+  skip_get_ec2_platforms      = true
+  skip_metadata_api_check     = true
+  skip_requesting_account_id  = true
+}
+`, tagRegex1, tagRegex2))
+}
+
 func testAccProviderConfig_defaultTagsEmptyConfigurationBlock() string {
 	//lintignore:AT004
 	return ConfigCompose(
@@ -1169,6 +1285,33 @@ provider "aws" {
 `, region))
 }
 
+// testAccProviderConfig_defaultTimeouts returns the configuration of a transit gateway, whose
+// creation takes minutes, with the specified provider default and resource create timeouts.
+func testAccProviderConfig_defaultTimeouts(defaultCreateTimeout, createTimeout string) string {
+	timeouts := ""
+
+	if createTimeout != "" {
+		timeouts = fmt.Sprintf(`
+  timeouts {
+    create = %[1]q
+  }
+`, createTimeout)
+	}
+
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_timeouts {
+    create         = %[1]q
+    resource_types = ["aws_ec2_transit_gateway"]
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+%[2]s}
+`, defaultCreateTimeout, timeouts)
+}
+
 func testAccProviderConfig_stsRegion(region, stsRegion string) string {
 	//lintignore:AT004
 	return ConfigCompose(
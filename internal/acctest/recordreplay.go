@@ -0,0 +1,676 @@
+package acctest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// Record and replay modes for acceptance testing.
+//
+// In record mode, AWS API requests made during each acceptance test are sent to AWS and the
+// interactions are saved, with account IDs and credentials scrubbed, to a cassette file per test.
+// In replay mode, responses are served from the cassette files and no requests are sent to AWS.
+const (
+	RecordModeRecord = "record"
+	RecordModeReplay = "replay"
+)
+
+const (
+	defaultCassetteDir = "testdata/cassettes"
+
+	accountIDPlaceholder = "{account_id}"
+	volatilePlaceholder  = "{volatile}"
+)
+
+var (
+	// Values which look like AWS account IDs.
+	accountIDValueRegexp = regexp.MustCompile(`\b\d{12}\b`)
+
+	// AWS access key IDs, both long and short term.
+	accessKeyIDValueRegexp = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+
+	// Secret values in XML and JSON bodies: temporary credentials, ECR and CodeArtifact authorization tokens,
+	// Secrets Manager secret values and KMS plaintexts.
+	secretXMLValueRegexp  = regexp.MustCompile(`(?i)(<(?:SecretAccessKey|SessionToken|AuthorizationToken|SecretString|SecretBinary|Plaintext)>)[^<]*(</)`)
+	secretJSONValueRegexp = regexp.MustCompile(`(?i)("(?:SecretAccessKey|SessionToken|AuthorizationToken|SecretString|SecretBinary|Plaintext)"\s*:\s*")(?:[^"\\]|\\.)*(")`)
+
+	// Credentials and signatures in the query strings of presigned URLs, e.g. the Lambda GetFunction Code.Location.
+	// Values end at the next parameter, which may be escaped as &amp; in XML or \u0026 in JSON.
+	presignedURLValueRegexp = regexp.MustCompile(`(X-Amz-(?:Security-Token|Signature|Credential)=)[^&"'<\s\\]*`)
+
+	// Values which differ between test runs: resource.UniqueId() suffixes, sdkacctest.RandomWithPrefix() suffixes
+	// and names with the acceptance test resource prefixes and a sdkacctest.RandString() or RandInt() suffix,
+	// e.g. tf-acc-test-1a2b3c, tf_acc_test_1a2b3c and tfacc1a2b3c.
+	volatileValueRegexp = regexp.MustCompile(`\btf[-_]?acc(?:[-_]?test)?[-_]?[0-9a-z]+|\d{18}[0-9a-f]{8}|\b\d{15,19}\b`)
+
+	// SigV4 credential scope in the Authorization header: Credential=AKID/20060102/us-west-2/ec2/aws4_request.
+	credentialScopeRegexp = regexp.MustCompile(`Credential=[^/]+/\d{8}/[^/]+/([^/]+)/aws4_request`)
+)
+
+// Idempotency token parameters populated automatically by the AWS SDKs, compared case-insensitively.
+var idempotencyTokenParameters = map[string]struct{}{
+	"clientrequesttoken": {},
+	"clienttoken":        {},
+	"idempotencytoken":   {},
+}
+
+// Request headers saved in cassettes.
+var cassetteRequestHeaders = []string{
+	"Content-Type",
+	"X-Amz-Target",
+}
+
+// Response headers not saved in cassettes.
+var cassetteExcludedResponseHeaders = []string{
+	"Date",
+	"Set-Cookie",
+}
+
+// RecordMode returns the record and replay mode from the environment, if any.
+func RecordMode() string {
+	return os.Getenv(conns.EnvVarAccRecordMode)
+}
+
+// CassetteDir returns the directory containing cassette files.
+func CassetteDir() string {
+	return conns.GetEnvVarWithDefault(conns.EnvVarAccCassetteDir, defaultCassetteDir)
+}
+
+// recordReplay tracks the cassettes of the running acceptance tests.
+// Tests using different cassettes run concurrently in record and replay mode.
+var recordReplay = &recordReplayState{
+	cassetteLocks: make(map[string]*sync.Mutex),
+	goroutines:    make(map[uint64]*cassette),
+	tests:         make(map[string]*cassette),
+}
+
+type recordReplayState struct {
+	mu sync.Mutex
+	// cassetteLocks serializes tests using the same cassette file, e.g. when run with -count.
+	cassetteLocks map[string]*sync.Mutex
+	// goroutines maps the goroutines of running tests to their cassettes.
+	goroutines map[uint64]*cassette
+	// tests maps the names of running tests to their cassettes.
+	tests map[string]*cassette
+}
+
+func (s *recordReplayState) cassetteLock(path string) *sync.Mutex {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	l, ok := s.cassetteLocks[path]
+
+	if !ok {
+		l = &sync.Mutex{}
+		s.cassetteLocks[path] = l
+	}
+
+	return l
+}
+
+// testCassette returns the cassette of the named test or of a running parent test.
+func (s *recordReplayState) testCassette(name string) *cassette {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for testName, c := range s.tests {
+		if name == testName || strings.HasPrefix(name, testName+"/") {
+			return c
+		}
+	}
+
+	return nil
+}
+
+func (s *recordReplayState) setTestCassette(name string, c *cassette) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c == nil {
+		delete(s.tests, name)
+	} else {
+		s.tests[name] = c
+	}
+}
+
+// goroutineCassette returns the cassette of the test running on the current goroutine, if any.
+func (s *recordReplayState) goroutineCassette() *cassette {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.goroutines[goroutineID()]
+}
+
+func (s *recordReplayState) setGoroutineCassette(id uint64, c *cassette) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c == nil {
+		delete(s.goroutines, id)
+	} else {
+		s.goroutines[id] = c
+	}
+}
+
+// startRecordReplay makes the cassette for the test active until the test completes.
+func startRecordReplay(t *testing.T, mode string) {
+	name, id := t.Name(), goroutineID()
+
+	// Nested or repeated PreCheck for a test that holds a cassette.
+	if c := recordReplay.testCassette(name); c != nil {
+		recordReplay.setGoroutineCassette(id, c)
+		t.Cleanup(func() {
+			recordReplay.setGoroutineCassette(id, nil)
+		})
+
+		return
+	}
+
+	path := filepath.Join(CassetteDir(), cassetteFileName(name))
+	lock := recordReplay.cassetteLock(path)
+	c := newCassette(mode)
+
+	lock.Lock()
+
+	t.Cleanup(func() {
+		defer lock.Unlock()
+
+		recordReplay.setGoroutineCassette(id, nil)
+		recordReplay.setTestCassette(name, nil)
+
+		if mode == RecordModeRecord {
+			if err := c.save(path); err != nil {
+				t.Errorf("error saving cassette (%s): %s", path, err)
+			}
+		}
+	})
+
+	if mode == RecordModeReplay {
+		if err := c.load(path); os.IsNotExist(err) {
+			t.Skipf("skipping acceptance test in %s mode: no cassette recorded (%s)", mode, path)
+		} else if err != nil {
+			t.Fatalf("error loading cassette (%s): %s", path, err)
+		}
+
+		setStaticTestCredentials()
+	}
+
+	recordReplay.setTestCassette(name, c)
+	recordReplay.setGoroutineCassette(id, c)
+}
+
+// cassetteFileName returns the cassette file name for the test.
+func cassetteFileName(testName string) string {
+	return strings.ReplaceAll(testName, "/", "__") + ".json"
+}
+
+// goroutineID returns the ID of the current goroutine, parsed from the header of its stack trace, e.g.
+// "goroutine 18 [running]:". A test's PreCheck, provider factories and check functions run on its goroutine.
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+
+	return id
+}
+
+// cassetteBinding is the cassette of the acceptance test a provider instance was created for.
+// Requests made by the provider instance's gRPC server do not run on the test's goroutine.
+type cassetteBinding struct {
+	mu       sync.Mutex
+	cassette *cassette
+}
+
+// bind binds the cassette of the test running on the current goroutine, if any.
+func (b *cassetteBinding) bind() {
+	if b == nil {
+		return
+	}
+
+	if c := recordReplay.goroutineCassette(); c != nil {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		b.cassette = c
+	}
+}
+
+func (b *cassetteBinding) bound() *cassette {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.cassette
+}
+
+// transportWrapper sends AWS API requests to the cassette of the test the provider instance was created for or,
+// for provider instances shared by tests such as Provider, to the cassette of the test making the request.
+func (b *cassetteBinding) transportWrapper(next http.RoundTripper) http.RoundTripper {
+	return &recordReplayTransport{binding: b, next: next}
+}
+
+type recordReplayTransport struct {
+	binding *cassetteBinding
+	next    http.RoundTripper
+}
+
+func (t *recordReplayTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	c := t.binding.bound()
+
+	if c == nil {
+		c = recordReplay.goroutineCassette()
+	}
+
+	if c == nil {
+		if RecordMode() == RecordModeReplay {
+			return nil, fmt.Errorf("%s mode: no cassette active for request (%s %s), acctest.PreCheck must be called", RecordModeReplay, r.Method, r.URL)
+		}
+
+		return t.next.RoundTrip(r)
+	}
+
+	return c.roundTrip(t.next, r)
+}
+
+// cassette is the set of AWS API interactions recorded for a single acceptance test.
+type cassette struct {
+	Interactions []*interaction `json:"interactions"`
+
+	mode string
+
+	mu sync.Mutex
+	// accountIDs maps recorded account IDs to their scrubbed values.
+	accountIDs map[string]string
+	// volatileValues maps volatile values in recorded interactions to their values in the current test run.
+	volatileValues map[string]string
+	// used tracks the recorded interactions already replayed.
+	used map[*interaction]bool
+}
+
+type interaction struct {
+	Request  *recordedRequest  `json:"request"`
+	Response *recordedResponse `json:"response"`
+}
+
+type recordedRequest struct {
+	Service string            `json:"service"`
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    recordedBody      `json:"body"`
+}
+
+type recordedResponse struct {
+	StatusCode int                 `json:"status_code"`
+	Headers    map[string][]string `json:"headers,omitempty"`
+	Body       recordedBody        `json:"body"`
+}
+
+// recordedBody is a request or response body, saved base64 encoded when not valid UTF-8.
+type recordedBody string
+
+func (b recordedBody) MarshalJSON() ([]byte, error) {
+	if utf8.ValidString(string(b)) {
+		return json.Marshal(string(b))
+	}
+
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString([]byte(b))})
+}
+
+func (b *recordedBody) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = recordedBody(s)
+		return nil
+	}
+
+	var encoded map[string]string
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded["base64"])
+	if err != nil {
+		return err
+	}
+
+	*b = recordedBody(decoded)
+
+	return nil
+}
+
+func newCassette(mode string) *cassette {
+	return &cassette{
+		mode:           mode,
+		accountIDs:     make(map[string]string),
+		volatileValues: make(map[string]string),
+		used:           make(map[*interaction]bool),
+	}
+}
+
+func (c *cassette) load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, c)
+}
+
+func (c *cassette) save(path string) error {
+	c.mu.Lock()
+	data, err := json.MarshalIndent(c, "", "  ")
+	c.mu.Unlock()
+
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644) //nolint:gosec
+}
+
+func (c *cassette) roundTrip(next http.RoundTripper, r *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.mode == RecordModeReplay {
+		return c.replay(r, body)
+	}
+
+	resp, err := next.RoundTrip(r)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	c.record(r, body, resp, respBody)
+
+	return resp, nil
+}
+
+// record saves the scrubbed interaction.
+func (c *cassette) record(r *http.Request, body []byte, resp *http.Response, respBody []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	req := &recordedRequest{
+		Service: requestService(r),
+		Method:  r.Method,
+		URL:     c.scrub(r.URL.String()),
+		Body:    recordedBody(c.scrub(string(body))),
+	}
+
+	for _, name := range cassetteRequestHeaders {
+		if v := r.Header.Get(name); v != "" {
+			if req.Headers == nil {
+				req.Headers = make(map[string]string)
+			}
+			req.Headers[name] = v
+		}
+	}
+
+	res := &recordedResponse{
+		StatusCode: resp.StatusCode,
+		Body:       recordedBody(c.scrub(string(respBody))),
+	}
+
+	header := resp.Header.Clone()
+	for _, name := range cassetteExcludedResponseHeaders {
+		header.Del(name)
+	}
+	for name, values := range header {
+		if res.Headers == nil {
+			res.Headers = make(map[string][]string)
+		}
+		for _, v := range values {
+			res.Headers[name] = append(res.Headers[name], c.scrub(v))
+		}
+	}
+
+	c.Interactions = append(c.Interactions, &interaction{Request: req, Response: res})
+}
+
+// replay returns the response of the recorded interaction matching the request.
+func (c *cassette) replay(r *http.Request, body []byte) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	service := requestService(r)
+	operation, canonicalBody := canonicalizeRequest(r.Method, r.URL, r.Header, body)
+	normalizedOperation, normalizedBody := normalizeValue(operation), normalizeValue(canonicalBody)
+
+	var match *interaction
+	var matchOperation, matchBody string
+	for _, i := range c.Interactions {
+		if i.Request.Service != service {
+			continue
+		}
+
+		u, err := url.Parse(i.Request.URL)
+		if err != nil {
+			continue
+		}
+
+		header := make(http.Header)
+		for k, v := range i.Request.Headers {
+			header.Set(k, v)
+		}
+
+		o, b := canonicalizeRequest(i.Request.Method, u, header, []byte(i.Request.Body))
+		if normalizeValue(o) != normalizedOperation || normalizeValue(b) != normalizedBody {
+			continue
+		}
+
+		// The first unused matching interaction, otherwise the last used, e.g. when waiting for a status longer than when recorded.
+		match, matchOperation, matchBody = i, o, b
+		if !c.used[i] {
+			break
+		}
+	}
+
+	if match == nil {
+		return nil, fmt.Errorf("%s mode: no recorded interaction matches request (%s %s: %s)", RecordModeReplay, service, normalizedOperation, normalizedBody)
+	}
+
+	c.used[match] = true
+	c.learnVolatileValues(matchOperation+matchBody, operation+canonicalBody)
+
+	log.Printf("[DEBUG] Replaying recorded %s %s response: %d", service, operation, match.Response.StatusCode)
+
+	respBody := c.substituteVolatileValues(string(match.Response.Body))
+	header := make(http.Header)
+	for name, values := range match.Response.Headers {
+		for _, v := range values {
+			header.Add(name, c.substituteVolatileValues(v))
+		}
+	}
+	header.Set("Content-Length", fmt.Sprintf("%d", len(respBody)))
+	if respBody != string(match.Response.Body) {
+		// Checksums of the recorded body no longer apply.
+		header.Del("X-Amz-Crc32")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(respBody)),
+		ContentLength: int64(len(respBody)),
+		Request:       r,
+	}, nil
+}
+
+// learnVolatileValues pairs volatile values, in order of appearance, in the recorded and current requests.
+func (c *cassette) learnVolatileValues(recorded, current string) {
+	recordedValues := volatileValueRegexp.FindAllString(recorded, -1)
+	currentValues := volatileValueRegexp.FindAllString(current, -1)
+
+	if len(recordedValues) != len(currentValues) {
+		return
+	}
+
+	for i, v := range recordedValues {
+		if _, ok := c.volatileValues[v]; !ok && v != currentValues[i] {
+			c.volatileValues[v] = currentValues[i]
+		}
+	}
+}
+
+func (c *cassette) substituteVolatileValues(s string) string {
+	return volatileValueRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		if current, ok := c.volatileValues[v]; ok {
+			return current
+		}
+
+		return v
+	})
+}
+
+// scrub removes credentials and replaces account IDs, consistently within the cassette.
+func (c *cassette) scrub(s string) string {
+	s = accessKeyIDValueRegexp.ReplaceAllString(s, "${1}IOSFODNN7EXAMPLE")
+	s = redactSecrets(s)
+
+	return accountIDValueRegexp.ReplaceAllStringFunc(s, func(v string) string {
+		if scrubbed, ok := c.accountIDs[v]; ok {
+			return scrubbed
+		}
+
+		scrubbed := fmt.Sprintf("%012d", len(c.accountIDs)+1)
+		c.accountIDs[v] = scrubbed
+
+		return scrubbed
+	})
+}
+
+// redactSecrets replaces secret values, which are never written to cassettes.
+func redactSecrets(s string) string {
+	s = secretXMLValueRegexp.ReplaceAllString(s, "${1}REDACTED${2}")
+	s = secretJSONValueRegexp.ReplaceAllString(s, "${1}REDACTED${2}")
+
+	return presignedURLValueRegexp.ReplaceAllString(s, "${1}REDACTED")
+}
+
+// readRequestBody returns the request body, leaving it readable.
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// requestService returns the signing name of the AWS service for the request.
+func requestService(r *http.Request) string {
+	if m := credentialScopeRegexp.FindStringSubmatch(r.Header.Get("Authorization")); m != nil {
+		return m[1]
+	}
+
+	return strings.SplitN(r.URL.Hostname(), ".", 2)[0]
+}
+
+// canonicalizeRequest returns the operation and body of a request in a canonical form,
+// without idempotency tokens, for matching requests with recorded interactions.
+func canonicalizeRequest(method string, u *url.URL, header http.Header, body []byte) (string, string) {
+	canonicalBody := string(body)
+	contentType := header.Get("Content-Type")
+
+	var operation string
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		if values, err := url.ParseQuery(canonicalBody); err == nil {
+			operation = values.Get("Action")
+			removeIdempotencyTokens(values)
+			canonicalBody = values.Encode()
+		}
+	case strings.Contains(contentType, "json"):
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err == nil {
+			if m, ok := v.(map[string]interface{}); ok {
+				for k := range m {
+					if _, ok := idempotencyTokenParameters[strings.ToLower(k)]; ok {
+						delete(m, k)
+					}
+				}
+			}
+
+			if b, err := json.Marshal(v); err == nil {
+				canonicalBody = string(b)
+			}
+		}
+	}
+
+	if target := header.Get("X-Amz-Target"); target != "" {
+		operation = target
+	}
+
+	if operation == "" {
+		query := u.Query()
+		removeIdempotencyTokens(query)
+		operation = fmt.Sprintf("%s %s%s?%s", method, u.Host, u.EscapedPath(), query.Encode())
+	}
+
+	return operation, canonicalBody
+}
+
+func removeIdempotencyTokens(values url.Values) {
+	for k := range values {
+		if _, ok := idempotencyTokenParameters[strings.ToLower(k)]; ok {
+			values.Del(k)
+		}
+	}
+}
+
+// normalizeValue replaces values which differ between test runs, scrubbed account IDs and redacted secrets.
+func normalizeValue(s string) string {
+	s = redactSecrets(s)
+	s = volatileValueRegexp.ReplaceAllString(s, volatilePlaceholder)
+
+	return accountIDValueRegexp.ReplaceAllString(s, accountIDPlaceholder)
+}
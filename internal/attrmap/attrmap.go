@@ -1,9 +1,11 @@
 package attrmap
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -17,7 +19,12 @@ type attributeInfo struct {
 	tfType           schema.ValueType
 	tfComputed       bool
 	tfOptional       bool
+	tfElem           interface{}
+	tfMaxItems       int
 	isIAMPolicy      bool
+	isJSON           bool
+	durationUnit     time.Duration
+	nested           AttributeMap
 }
 
 type AttributeMap map[string]attributeInfo
@@ -35,6 +42,8 @@ func New(attrMap map[string]string, schemaMap map[string]*schema.Schema) Attribu
 
 			attributeInfo.tfComputed = s.Computed
 			attributeInfo.tfOptional = s.Optional
+			attributeInfo.tfElem = s.Elem
+			attributeInfo.tfMaxItems = s.MaxItems
 
 			attributeMap[tfAttributeName] = attributeInfo
 		} else {
@@ -68,7 +77,8 @@ func (m AttributeMap) APIAttributesToResourceData(apiAttributes map[string]strin
 			case schema.TypeString:
 				tfAttributeValue = v
 
-				if attributeInfo.isIAMPolicy {
+				switch {
+				case attributeInfo.isIAMPolicy:
 					policy, err := verify.PolicyToSet(d.Get(tfAttributeName).(string), tfAttributeValue.(string))
 
 					if err != nil {
@@ -76,6 +86,24 @@ func (m AttributeMap) APIAttributesToResourceData(apiAttributes map[string]strin
 					}
 
 					tfAttributeValue = policy
+				case attributeInfo.isJSON:
+					tfAttributeValue, err = jsonToSet(d.Get(tfAttributeName).(string), v)
+
+					if err != nil {
+						return fmt.Errorf("error parsing %s value (%s) into JSON: %w", tfAttributeName, v, err)
+					}
+				case attributeInfo.durationUnit != 0:
+					tfAttributeValue, err = attributeInfo.durationToSet(d.Get(tfAttributeName).(string), v)
+
+					if err != nil {
+						return fmt.Errorf("error parsing %s value (%s) into duration: %w", tfAttributeName, v, err)
+					}
+				}
+			case schema.TypeList, schema.TypeSet:
+				tfAttributeValue, err = attributeInfo.listFromAPIAttributeValue(v)
+
+				if err != nil {
+					return fmt.Errorf("error parsing %s value (%s) into list: %w", tfAttributeName, v, err)
 				}
 			default:
 				return fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -117,16 +145,20 @@ func (m AttributeMap) ResourceDataToAPIAttributesCreate(d *schema.ResourceData)
 				apiAttributeValue = strconv.Itoa(v)
 			}
 		case schema.TypeString:
-			apiAttributeValue = v.(string)
+			var err error
 
-			if attributeInfo.isIAMPolicy && apiAttributeValue != "" {
-				policy, err := structure.NormalizeJsonString(apiAttributeValue)
+			apiAttributeValue, err = attributeInfo.stringToAPIAttributeValue(v.(string))
 
-				if err != nil {
-					return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
-				}
+			if err != nil {
+				return nil, fmt.Errorf("attribute %s: %w", tfAttributeName, err)
+			}
+		case schema.TypeList, schema.TypeSet:
+			var err error
+
+			apiAttributeValue, err = attributeInfo.listToAPIAttributeValue(v)
 
-				apiAttributeValue = policy
+			if err != nil {
+				return nil, fmt.Errorf("attribute %s: %w", tfAttributeName, err)
 			}
 		default:
 			return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -156,22 +188,24 @@ func (m AttributeMap) ResourceDataToAPIAttributesUpdate(d *schema.ResourceData)
 
 			var apiAttributeValue string
 
+			var err error
+
 			switch t := attributeInfo.tfType; t {
 			case schema.TypeBool:
 				apiAttributeValue = strconv.FormatBool(v.(bool))
 			case schema.TypeInt:
 				apiAttributeValue = strconv.Itoa(v.(int))
 			case schema.TypeString:
-				apiAttributeValue = v.(string)
-
-				if attributeInfo.isIAMPolicy {
-					policy, err := structure.NormalizeJsonString(apiAttributeValue)
+				apiAttributeValue, err = attributeInfo.stringToAPIAttributeValue(v.(string))
 
-					if err != nil {
-						return nil, fmt.Errorf("policy (%s) is invalid JSON: %w", apiAttributeValue, err)
-					}
+				if err != nil {
+					return nil, fmt.Errorf("attribute %s: %w", tfAttributeName, err)
+				}
+			case schema.TypeList, schema.TypeSet:
+				apiAttributeValue, err = attributeInfo.listToAPIAttributeValue(v)
 
-					apiAttributeValue = policy
+				if err != nil {
+					return nil, fmt.Errorf("attribute %s: %w", tfAttributeName, err)
 				}
 			default:
 				return nil, fmt.Errorf("attribute %s is of unsupported type: %d", tfAttributeName, t)
@@ -201,7 +235,403 @@ func (m AttributeMap) APIAttributeNames() []string {
 func (m AttributeMap) WithIAMPolicyAttribute(tfAttributeName string) AttributeMap {
 	if attributeInfo, ok := m[tfAttributeName]; ok {
 		attributeInfo.isIAMPolicy = true
+		m[tfAttributeName] = attributeInfo
 	}
 
 	return m
 }
+
+// WithJSONAttribute marks the specified Terraform attribute as holding a JSON document.
+// JSON documents are normalized and semantically equivalent values returned by AWS don't change the Terraform attribute.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithJSONAttribute(tfAttributeName string) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		attributeInfo.isJSON = true
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// WithDurationAttribute marks the specified Terraform attribute as holding a duration string, e.g. "5m".
+// The AWS API attribute holds the duration as an integer number of the specified unit, e.g. time.Second.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithDurationAttribute(tfAttributeName string, unit time.Duration) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		attributeInfo.durationUnit = unit
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// WithNestedAttributeMap maps the nested block of the specified Terraform attribute to a JSON AWS API attribute.
+// The attribute map is from the nested block's attribute names to the JSON object's keys.
+// A block with MaxItems of 1 is a JSON object, otherwise a JSON array of objects.
+// It panics if the attribute is not a nested block, as attribute maps are built at initialization.
+// This method is intended to be chained with other similar helper methods in a builder pattern.
+func (m AttributeMap) WithNestedAttributeMap(tfAttributeName string, attrMap map[string]string) AttributeMap {
+	if attributeInfo, ok := m[tfAttributeName]; ok {
+		r, ok := attributeInfo.tfElem.(*schema.Resource)
+
+		if !ok {
+			panic(fmt.Sprintf("attribute %s is not a nested block", tfAttributeName)) //lintignore:R009
+		}
+
+		attributeInfo.nested = New(attrMap, r.Schema)
+		m[tfAttributeName] = attributeInfo
+	}
+
+	return m
+}
+
+// stringToAPIAttributeValue returns the AWS API attribute value of a string attribute.
+func (a attributeInfo) stringToAPIAttributeValue(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+
+	switch {
+	case a.isIAMPolicy:
+		policy, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return "", fmt.Errorf("policy (%s) is invalid JSON: %w", v, err)
+		}
+
+		return policy, nil
+	case a.isJSON:
+		json, err := structure.NormalizeJsonString(v)
+
+		if err != nil {
+			return "", fmt.Errorf("value (%s) is invalid JSON: %w", v, err)
+		}
+
+		return json, nil
+	case a.durationUnit != 0:
+		duration, err := time.ParseDuration(v)
+
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatInt(int64(duration/a.durationUnit), 10), nil
+	}
+
+	return v, nil
+}
+
+// durationToSet returns the existing duration string if equivalent to the AWS API attribute value, otherwise the API value as a duration string.
+func (a attributeInfo) durationToSet(exist, v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+
+	n, err := strconv.ParseInt(v, 10, 64)
+
+	if err != nil {
+		return "", err
+	}
+
+	duration := time.Duration(n) * a.durationUnit
+
+	if existing, err := time.ParseDuration(exist); err == nil && existing == duration {
+		return exist, nil
+	}
+
+	return duration.String(), nil
+}
+
+// jsonToSet returns the existing JSON document if equivalent to the AWS API attribute value, otherwise the normalized API value.
+func jsonToSet(exist, v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+
+	if verify.JSONBytesEqual([]byte(exist), []byte(v)) {
+		return exist, nil
+	}
+
+	return structure.NormalizeJsonString(v)
+}
+
+// listToAPIAttributeValue returns the AWS API attribute value, a JSON array or object, of a list, set or nested block attribute.
+func (a attributeInfo) listToAPIAttributeValue(v interface{}) (string, error) {
+	value, err := a.toJSONValue(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	if value == nil {
+		return "", nil
+	}
+
+	b, err := json.Marshal(value)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// listFromAPIAttributeValue returns the list, set or nested block attribute value of an AWS API attribute value.
+func (a attributeInfo) listFromAPIAttributeValue(v string) (interface{}, error) {
+	var value interface{}
+
+	if v != "" {
+		if err := json.Unmarshal([]byte(v), &value); err != nil {
+			return nil, err
+		}
+	}
+
+	return a.fromJSONValue(value)
+}
+
+// toJSONValue returns the JSON value of a Terraform attribute value. Empty values are nil.
+func (a attributeInfo) toJSONValue(v interface{}) (interface{}, error) {
+	switch a.tfType {
+	case schema.TypeBool, schema.TypeInt:
+		return v, nil
+	case schema.TypeString:
+		s, _ := v.(string)
+
+		if s == "" {
+			return nil, nil
+		}
+
+		switch {
+		case a.isIAMPolicy || a.isJSON:
+			var value interface{}
+
+			if err := json.Unmarshal([]byte(s), &value); err != nil {
+				return nil, fmt.Errorf("value (%s) is invalid JSON: %w", s, err)
+			}
+
+			return value, nil
+		case a.durationUnit != 0:
+			duration, err := time.ParseDuration(s)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return int64(duration / a.durationUnit), nil
+		}
+
+		return s, nil
+	case schema.TypeList, schema.TypeSet:
+		var items []interface{}
+
+		switch v := v.(type) {
+		case []interface{}:
+			items = v
+		case *schema.Set:
+			items = v.List()
+		}
+
+		if len(items) == 0 {
+			return nil, nil
+		}
+
+		values := make([]interface{}, 0, len(items))
+
+		for _, item := range items {
+			var value interface{}
+			var err error
+
+			if a.nested != nil {
+				tfMap, _ := item.(map[string]interface{})
+				value, err = a.nested.toJSONObject(tfMap)
+			} else {
+				value, err = a.elemInfo().toJSONValue(item)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		if a.nested != nil && a.tfMaxItems == 1 {
+			return values[0], nil
+		}
+
+		return values, nil
+	}
+
+	return nil, fmt.Errorf("unsupported type: %d", a.tfType)
+}
+
+// fromJSONValue returns the Terraform attribute value of a JSON value.
+// Scalar values may be encoded as JSON strings.
+func (a attributeInfo) fromJSONValue(v interface{}) (interface{}, error) {
+	switch a.tfType {
+	case schema.TypeBool:
+		switch v := v.(type) {
+		case nil:
+			return false, nil
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(v)
+		}
+	case schema.TypeInt:
+		switch v := v.(type) {
+		case nil:
+			return 0, nil
+		case float64:
+			return int(v), nil
+		case string:
+			return strconv.Atoi(v)
+		}
+	case schema.TypeString:
+		switch {
+		case v == nil:
+			return "", nil
+		case a.isIAMPolicy || a.isJSON:
+			if s, ok := v.(string); ok {
+				return s, nil
+			}
+
+			b, err := json.Marshal(v)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return string(b), nil
+		case a.durationUnit != 0:
+			var n int64
+
+			switch v := v.(type) {
+			case float64:
+				n = int64(v)
+			case string:
+				var err error
+
+				if n, err = strconv.ParseInt(v, 10, 64); err != nil {
+					return nil, err
+				}
+			default:
+				return nil, fmt.Errorf("unexpected duration value: %v", v)
+			}
+
+			return (time.Duration(n) * a.durationUnit).String(), nil
+		}
+
+		switch v := v.(type) {
+		case string:
+			return v, nil
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64), nil
+		case bool:
+			return strconv.FormatBool(v), nil
+		}
+	case schema.TypeList, schema.TypeSet:
+		var items []interface{}
+
+		switch v := v.(type) {
+		case nil:
+		case []interface{}:
+			items = v
+		default:
+			items = []interface{}{v}
+		}
+
+		values := make([]interface{}, 0, len(items))
+
+		for _, item := range items {
+			var value interface{}
+			var err error
+
+			if a.nested != nil {
+				apiObject, ok := item.(map[string]interface{})
+
+				if !ok {
+					return nil, fmt.Errorf("unexpected nested block value: %v", item)
+				}
+
+				value, err = a.nested.fromJSONObject(apiObject)
+			} else {
+				value, err = a.elemInfo().fromJSONValue(item)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("unsupported type: %d", a.tfType)
+	}
+
+	return nil, fmt.Errorf("unexpected value: %v", v)
+}
+
+// elemInfo returns the attribute information of the elements of a list or set of scalar values.
+func (a attributeInfo) elemInfo() attributeInfo {
+	if s, ok := a.tfElem.(*schema.Schema); ok {
+		return attributeInfo{tfType: s.Type}
+	}
+
+	return attributeInfo{tfType: schema.TypeString}
+}
+
+// toJSONObject returns the JSON object of a nested block.
+// Zero values of optional attributes are omitted.
+func (m AttributeMap) toJSONObject(tfMap map[string]interface{}) (map[string]interface{}, error) {
+	apiObject := make(map[string]interface{})
+
+	for tfAttributeName, attributeInfo := range m {
+		v, ok := tfMap[tfAttributeName]
+
+		if !ok {
+			continue
+		}
+
+		if attributeInfo.tfOptional {
+			if b, ok := v.(bool); ok && !b {
+				continue
+			}
+
+			if i, ok := v.(int); ok && i == 0 {
+				continue
+			}
+		}
+
+		value, err := attributeInfo.toJSONValue(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", tfAttributeName, err)
+		}
+
+		if value != nil {
+			apiObject[attributeInfo.apiAttributeName] = value
+		}
+	}
+
+	return apiObject, nil
+}
+
+// fromJSONObject returns the nested block of a JSON object.
+func (m AttributeMap) fromJSONObject(apiObject map[string]interface{}) (map[string]interface{}, error) {
+	tfMap := make(map[string]interface{})
+
+	for tfAttributeName, attributeInfo := range m {
+		value, err := attributeInfo.fromJSONValue(apiObject[attributeInfo.apiAttributeName])
+
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", tfAttributeName, err)
+		}
+
+		tfMap[tfAttributeName] = value
+	}
+
+	return tfMap, nil
+}
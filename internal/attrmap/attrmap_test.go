@@ -0,0 +1,303 @@
+package attrmap_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/attrmap"
+)
+
+var testSchema = map[string]*schema.Schema{
+	"delay": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"document": {
+		Type:     schema.TypeString,
+		Optional: true,
+	},
+	"enabled": {
+		Type:     schema.TypeBool,
+		Optional: true,
+	},
+	"names": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	},
+	"redrive_policy": {
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dead_letter_target_arn": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"max_receive_count": {
+					Type:     schema.TypeInt,
+					Required: true,
+				},
+			},
+		},
+	},
+	"rules": {
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"priority": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+			},
+		},
+	},
+}
+
+func testAttributeMap() attrmap.AttributeMap {
+	return attrmap.New(map[string]string{
+		"delay":          "Delay",
+		"document":       "Document",
+		"enabled":        "Enabled",
+		"names":          "Names",
+		"redrive_policy": "RedrivePolicy",
+		"rules":          "Rules",
+	}, testSchema).
+		WithDurationAttribute("delay", time.Second).
+		WithJSONAttribute("document").
+		WithNestedAttributeMap("redrive_policy", map[string]string{
+			"dead_letter_target_arn": "deadLetterTargetArn",
+			"max_receive_count":      "maxReceiveCount",
+		}).
+		WithNestedAttributeMap("rules", map[string]string{
+			"name":     "Name",
+			"priority": "Priority",
+		})
+}
+
+func TestResourceDataToAPIAttributesCreate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+		"delay":    "2m",
+		"document": `{ "b": 2, "a": [1, 2] }`,
+		"enabled":  true,
+		"names":    []interface{}{"one", "two"},
+		"redrive_policy": []interface{}{
+			map[string]interface{}{
+				"dead_letter_target_arn": "arn:aws:sqs:us-west-2:123456789012:dlq", //lintignore:AWSAT003,AWSAT005
+				"max_receive_count":      5,
+			},
+		},
+	})
+
+	got, err := testAttributeMap().ResourceDataToAPIAttributesCreate(d)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"Delay":         "120",
+		"Document":      `{"a":[1,2],"b":2}`,
+		"Enabled":       "true",
+		"Names":         `["one","two"]`,
+		"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":5}`, //lintignore:AWSAT003,AWSAT005
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestAPIAttributesToResourceData(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+		"delay":    "2m",
+		"document": `{"b":2,"a":[1,2]}`,
+	})
+
+	apiAttributes := map[string]string{
+		"Delay":         "120",
+		"Document":      `{"a": [1, 2], "b": 2}`,
+		"Enabled":       "false",
+		"Names":         `["one","two"]`,
+		"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-west-2:123456789012:dlq","maxReceiveCount":"5"}`, //lintignore:AWSAT003,AWSAT005
+	}
+
+	if err := testAttributeMap().APIAttributesToResourceData(apiAttributes, d); err != nil {
+		t.Fatal(err)
+	}
+
+	// Equivalent values keep the configured values.
+	if got, expected := d.Get("delay").(string), "2m"; got != expected {
+		t.Errorf("got delay %q, expected %q", got, expected)
+	}
+
+	if got, expected := d.Get("document").(string), `{"b":2,"a":[1,2]}`; got != expected {
+		t.Errorf("got document %q, expected %q", got, expected)
+	}
+
+	if got, expected := d.Get("names").([]interface{}), []interface{}{"one", "two"}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got names %v, expected %v", got, expected)
+	}
+
+	if got, expected := d.Get("redrive_policy.0.dead_letter_target_arn").(string), "arn:aws:sqs:us-west-2:123456789012:dlq"; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got dead_letter_target_arn %q, expected %q", got, expected)
+	}
+
+	if got, expected := d.Get("redrive_policy.0.max_receive_count").(int), 5; got != expected {
+		t.Errorf("got max_receive_count %d, expected %d", got, expected)
+	}
+
+	apiAttributes["Delay"] = "90"
+	apiAttributes["Document"] = `{"c":3}`
+
+	if err := testAttributeMap().APIAttributesToResourceData(apiAttributes, d); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, expected := d.Get("delay").(string), "1m30s"; got != expected {
+		t.Errorf("got delay %q, expected %q", got, expected)
+	}
+
+	if got, expected := d.Get("document").(string), `{"c":3}`; got != expected {
+		t.Errorf("got document %q, expected %q", got, expected)
+	}
+}
+
+func TestResourceDataToAPIAttributesUpdate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{
+		"delay": "1h",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"name":     "b",
+				"priority": 2,
+			},
+			map[string]interface{}{
+				"name": "a",
+			},
+		},
+	})
+
+	got, err := testAttributeMap().ResourceDataToAPIAttributesUpdate(d)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		"Delay": "3600",
+		"Rules": `[{"Name":"b","Priority":2},{"Name":"a"}]`,
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestAttributesRoundTrip(t *testing.T) {
+	config := map[string]interface{}{
+		"delay":    "1m30s",
+		"document": `{"a":[1,2],"b":2}`,
+		"enabled":  true,
+		"names":    []interface{}{"one", "two"},
+		"redrive_policy": []interface{}{
+			map[string]interface{}{
+				"dead_letter_target_arn": "arn:aws:sqs:us-west-2:123456789012:dlq", //lintignore:AWSAT003,AWSAT005
+				"max_receive_count":      5,
+			},
+		},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"name":     "b",
+				"priority": 2,
+			},
+			map[string]interface{}{
+				"name":     "a",
+				"priority": 0,
+			},
+		},
+	}
+
+	apiAttributes, err := testAttributeMap().ResourceDataToAPIAttributesCreate(schema.TestResourceDataRaw(t, testSchema, config))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, testSchema, map[string]interface{}{})
+
+	if err := testAttributeMap().APIAttributesToResourceData(apiAttributes, d); err != nil {
+		t.Fatal(err)
+	}
+
+	for k, expected := range config {
+		if got := d.Get(k); !reflect.DeepEqual(got, expected) {
+			t.Errorf("got %s %v, expected %v", k, got, expected)
+		}
+	}
+}
+
+func TestWithNestedAttributeMapNotNestedBlock(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+
+	attrmap.New(map[string]string{
+		"names": "Names",
+	}, testSchema).WithNestedAttributeMap("names", map[string]string{})
+}
+
+func TestIAMPolicyAttribute(t *testing.T) {
+	policySchema := map[string]*schema.Schema{
+		"policy": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+	m := attrmap.New(map[string]string{
+		"policy": "Policy",
+	}, policySchema).WithIAMPolicyAttribute("policy")
+	policy := `{"Statement":[{"Action":["sqs:SendMessage","sqs:ReceiveMessage"],"Effect":"Allow","Resource":"*"}],"Version":"2012-10-17"}`
+
+	d := schema.TestResourceDataRaw(t, policySchema, map[string]interface{}{
+		"policy": `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["sqs:SendMessage", "sqs:ReceiveMessage"], "Resource": "*"}]}`,
+	})
+
+	got, err := m.ResourceDataToAPIAttributesCreate(d)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := map[string]string{"Policy": policy}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+
+	// Equivalent policies keep the configured policy, normalized.
+	apiAttributes := map[string]string{
+		"Policy": `{"Statement":[{"Action":["sqs:ReceiveMessage","sqs:SendMessage"],"Effect":"Allow","Resource":["*"]}],"Version":"2012-10-17"}`,
+	}
+
+	if err := m.APIAttributesToResourceData(apiAttributes, d); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := d.Get("policy").(string); got != policy {
+		t.Errorf("got policy %s, expected %s", got, policy)
+	}
+
+	if _, err := m.ResourceDataToAPIAttributesCreate(schema.TestResourceDataRaw(t, policySchema, map[string]interface{}{
+		"policy": `{"Statement":`,
+	})); err == nil {
+		t.Error("expected error for invalid policy JSON")
+	}
+}
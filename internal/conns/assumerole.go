@@ -0,0 +1,74 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// assumeRoleChain returns the configured IAM Roles to assume, in order.
+// Entries without a role ARN are skipped.
+func (c *Config) assumeRoleChain() []*awsbase.AssumeRole {
+	var chain []*awsbase.AssumeRole
+
+	for _, v := range c.AssumeRoles {
+		if v == nil || v.RoleARN == "" {
+			continue
+		}
+
+		chain = append(chain, v)
+	}
+
+	return chain
+}
+
+// assumeRoleCredentialsProvider returns a credentials provider for the specified IAM Role,
+// assumed using the credentials from the specified AWS SDK for Go v2 configuration.
+func (c *Config) assumeRoleCredentialsProvider(ctx context.Context, cfg aws.Config, ar *awsbase.AssumeRole) (aws.CredentialsProvider, error) {
+	log.Printf("[INFO] Assuming chained IAM Role %q (SessionName: %q, ExternalId: %q)", ar.RoleARN, ar.SessionName, ar.ExternalID)
+
+	client := sts.NewFromConfig(cfg, func(o *sts.Options) {
+		if endpoint := c.Endpoints[names.STS]; endpoint != "" {
+			o.EndpointResolver = sts.EndpointResolverFromURL(endpoint)
+		}
+		if c.STSRegion != "" {
+			o.Region = c.STSRegion
+		}
+	})
+
+	provider := stscreds.NewAssumeRoleProvider(client, ar.RoleARN, func(opts *stscreds.AssumeRoleOptions) {
+		opts.RoleSessionName = ar.SessionName
+		opts.Duration = ar.Duration
+
+		if ar.ExternalID != "" {
+			opts.ExternalID = aws.String(ar.ExternalID)
+		}
+
+		if ar.Policy != "" {
+			opts.Policy = aws.String(ar.Policy)
+		}
+
+		for _, v := range ar.PolicyARNs {
+			opts.PolicyARNs = append(opts.PolicyARNs, types.PolicyDescriptorType{Arn: aws.String(v)})
+		}
+
+		for k, v := range ar.Tags {
+			opts.Tags = append(opts.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+
+		opts.TransitiveTagKeys = ar.TransitiveTagKeys
+	})
+
+	if _, err := provider.Retrieve(ctx); err != nil {
+		return nil, fmt.Errorf("assuming IAM Role (%s): %w", ar.RoleARN, err)
+	}
+
+	return aws.NewCredentialsCache(provider), nil
+}
@@ -0,0 +1,47 @@
+package conns
+
+import (
+	"reflect"
+	"testing"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+)
+
+func TestConfigAssumeRoleChain(t *testing.T) {
+	hub := &awsbase.AssumeRole{RoleARN: "arn:aws:iam::111111111111:role/hub"}                            //lintignore:AWSAT005
+	spoke := &awsbase.AssumeRole{RoleARN: "arn:aws:iam::222222222222:role/spoke", ExternalID: "example"} //lintignore:AWSAT005
+
+	testCases := []struct {
+		Name        string
+		AssumeRoles []*awsbase.AssumeRole
+		Expected    []*awsbase.AssumeRole
+	}{
+		{
+			Name: "none",
+		},
+		{
+			Name:        "empty",
+			AssumeRoles: []*awsbase.AssumeRole{nil, {}},
+		},
+		{
+			Name:        "single",
+			AssumeRoles: []*awsbase.AssumeRole{hub},
+			Expected:    []*awsbase.AssumeRole{hub},
+		},
+		{
+			Name:        "chain",
+			AssumeRoles: []*awsbase.AssumeRole{hub, {}, spoke},
+			Expected:    []*awsbase.AssumeRole{hub, spoke},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			c := &Config{AssumeRoles: testCase.AssumeRoles}
+
+			if got := c.assumeRoleChain(); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/kendra"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -310,18 +311,23 @@ import (
 
 type AWSClient struct {
 	AccountID                 string
+	AssumedRoleARNs           []string
 	DefaultTagsConfig         *tftags.DefaultConfig
+	DefaultTimeoutsConfig     *DefaultTimeoutsConfig
 	DNSSuffix                 string
 	IgnoreTagsConfig          *tftags.IgnoreConfig
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
 	Region                    string
+	RequiredTagsConfig        *tftags.RequiredConfig
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
 	Session                   *session.Session
 	SupportedPlatforms        []string
 	TerraformVersion          string
 
+	resourceTypeClients *resourceTypeClients
+
 	ACMConn                          *acm.ACM
 	ACMPCAConn                       *acmpca.ACMPCA
 	AMPConn                          *prometheusservice.PrometheusService
@@ -637,3 +643,908 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// addServiceClientHandler replaces the AWS SDK for Go v1 service clients with copies
+// which run the handler first when validating each request.
+func (client *AWSClient) addServiceClientHandler(handler request.NamedHandler) {
+	if client.MediaConvertAccountConn != nil {
+		client.MediaConvertAccountConn = &mediaconvert.MediaConvert{Client: withValidateHandler(client.MediaConvertAccountConn.Client, handler)}
+	}
+	if client.S3ConnURICleaningDisabled != nil {
+		client.S3ConnURICleaningDisabled = &s3.S3{Client: withValidateHandler(client.S3ConnURICleaningDisabled.Client, handler)}
+	}
+	if client.ACMConn != nil {
+		client.ACMConn = &acm.ACM{Client: withValidateHandler(client.ACMConn.Client, handler)}
+	}
+	if client.ACMPCAConn != nil {
+		client.ACMPCAConn = &acmpca.ACMPCA{Client: withValidateHandler(client.ACMPCAConn.Client, handler)}
+	}
+	if client.AMPConn != nil {
+		client.AMPConn = &prometheusservice.PrometheusService{Client: withValidateHandler(client.AMPConn.Client, handler)}
+	}
+	if client.APIGatewayConn != nil {
+		client.APIGatewayConn = &apigateway.APIGateway{Client: withValidateHandler(client.APIGatewayConn.Client, handler)}
+	}
+	if client.APIGatewayManagementAPIConn != nil {
+		client.APIGatewayManagementAPIConn = &apigatewaymanagementapi.ApiGatewayManagementApi{Client: withValidateHandler(client.APIGatewayManagementAPIConn.Client, handler)}
+	}
+	if client.APIGatewayV2Conn != nil {
+		client.APIGatewayV2Conn = &apigatewayv2.ApiGatewayV2{Client: withValidateHandler(client.APIGatewayV2Conn.Client, handler)}
+	}
+	if client.AccessAnalyzerConn != nil {
+		client.AccessAnalyzerConn = &accessanalyzer.AccessAnalyzer{Client: withValidateHandler(client.AccessAnalyzerConn.Client, handler)}
+	}
+	if client.AccountConn != nil {
+		client.AccountConn = &account.Account{Client: withValidateHandler(client.AccountConn.Client, handler)}
+	}
+	if client.AlexaForBusinessConn != nil {
+		client.AlexaForBusinessConn = &alexaforbusiness.AlexaForBusiness{Client: withValidateHandler(client.AlexaForBusinessConn.Client, handler)}
+	}
+	if client.AmplifyConn != nil {
+		client.AmplifyConn = &amplify.Amplify{Client: withValidateHandler(client.AmplifyConn.Client, handler)}
+	}
+	if client.AmplifyBackendConn != nil {
+		client.AmplifyBackendConn = &amplifybackend.AmplifyBackend{Client: withValidateHandler(client.AmplifyBackendConn.Client, handler)}
+	}
+	if client.AmplifyUIBuilderConn != nil {
+		client.AmplifyUIBuilderConn = &amplifyuibuilder.AmplifyUIBuilder{Client: withValidateHandler(client.AmplifyUIBuilderConn.Client, handler)}
+	}
+	if client.AppAutoScalingConn != nil {
+		client.AppAutoScalingConn = &applicationautoscaling.ApplicationAutoScaling{Client: withValidateHandler(client.AppAutoScalingConn.Client, handler)}
+	}
+	if client.AppConfigConn != nil {
+		client.AppConfigConn = &appconfig.AppConfig{Client: withValidateHandler(client.AppConfigConn.Client, handler)}
+	}
+	if client.AppConfigDataConn != nil {
+		client.AppConfigDataConn = &appconfigdata.AppConfigData{Client: withValidateHandler(client.AppConfigDataConn.Client, handler)}
+	}
+	if client.AppFlowConn != nil {
+		client.AppFlowConn = &appflow.Appflow{Client: withValidateHandler(client.AppFlowConn.Client, handler)}
+	}
+	if client.AppIntegrationsConn != nil {
+		client.AppIntegrationsConn = &appintegrationsservice.AppIntegrationsService{Client: withValidateHandler(client.AppIntegrationsConn.Client, handler)}
+	}
+	if client.AppMeshConn != nil {
+		client.AppMeshConn = &appmesh.AppMesh{Client: withValidateHandler(client.AppMeshConn.Client, handler)}
+	}
+	if client.AppRunnerConn != nil {
+		client.AppRunnerConn = &apprunner.AppRunner{Client: withValidateHandler(client.AppRunnerConn.Client, handler)}
+	}
+	if client.AppStreamConn != nil {
+		client.AppStreamConn = &appstream.AppStream{Client: withValidateHandler(client.AppStreamConn.Client, handler)}
+	}
+	if client.AppSyncConn != nil {
+		client.AppSyncConn = &appsync.AppSync{Client: withValidateHandler(client.AppSyncConn.Client, handler)}
+	}
+	if client.ApplicationCostProfilerConn != nil {
+		client.ApplicationCostProfilerConn = &applicationcostprofiler.ApplicationCostProfiler{Client: withValidateHandler(client.ApplicationCostProfilerConn.Client, handler)}
+	}
+	if client.ApplicationInsightsConn != nil {
+		client.ApplicationInsightsConn = &applicationinsights.ApplicationInsights{Client: withValidateHandler(client.ApplicationInsightsConn.Client, handler)}
+	}
+	if client.AthenaConn != nil {
+		client.AthenaConn = &athena.Athena{Client: withValidateHandler(client.AthenaConn.Client, handler)}
+	}
+	if client.AuditManagerConn != nil {
+		client.AuditManagerConn = &auditmanager.AuditManager{Client: withValidateHandler(client.AuditManagerConn.Client, handler)}
+	}
+	if client.AutoScalingConn != nil {
+		client.AutoScalingConn = &autoscaling.AutoScaling{Client: withValidateHandler(client.AutoScalingConn.Client, handler)}
+	}
+	if client.AutoScalingPlansConn != nil {
+		client.AutoScalingPlansConn = &autoscalingplans.AutoScalingPlans{Client: withValidateHandler(client.AutoScalingPlansConn.Client, handler)}
+	}
+	if client.BackupConn != nil {
+		client.BackupConn = &backup.Backup{Client: withValidateHandler(client.BackupConn.Client, handler)}
+	}
+	if client.BackupGatewayConn != nil {
+		client.BackupGatewayConn = &backupgateway.BackupGateway{Client: withValidateHandler(client.BackupGatewayConn.Client, handler)}
+	}
+	if client.BatchConn != nil {
+		client.BatchConn = &batch.Batch{Client: withValidateHandler(client.BatchConn.Client, handler)}
+	}
+	if client.BillingConductorConn != nil {
+		client.BillingConductorConn = &billingconductor.BillingConductor{Client: withValidateHandler(client.BillingConductorConn.Client, handler)}
+	}
+	if client.BraketConn != nil {
+		client.BraketConn = &braket.Braket{Client: withValidateHandler(client.BraketConn.Client, handler)}
+	}
+	if client.BudgetsConn != nil {
+		client.BudgetsConn = &budgets.Budgets{Client: withValidateHandler(client.BudgetsConn.Client, handler)}
+	}
+	if client.CEConn != nil {
+		client.CEConn = &costexplorer.CostExplorer{Client: withValidateHandler(client.CEConn.Client, handler)}
+	}
+	if client.CURConn != nil {
+		client.CURConn = &costandusagereportservice.CostandUsageReportService{Client: withValidateHandler(client.CURConn.Client, handler)}
+	}
+	if client.ChimeConn != nil {
+		client.ChimeConn = &chime.Chime{Client: withValidateHandler(client.ChimeConn.Client, handler)}
+	}
+	if client.ChimeSDKIdentityConn != nil {
+		client.ChimeSDKIdentityConn = &chimesdkidentity.ChimeSDKIdentity{Client: withValidateHandler(client.ChimeSDKIdentityConn.Client, handler)}
+	}
+	if client.ChimeSDKMeetingsConn != nil {
+		client.ChimeSDKMeetingsConn = &chimesdkmeetings.ChimeSDKMeetings{Client: withValidateHandler(client.ChimeSDKMeetingsConn.Client, handler)}
+	}
+	if client.ChimeSDKMessagingConn != nil {
+		client.ChimeSDKMessagingConn = &chimesdkmessaging.ChimeSDKMessaging{Client: withValidateHandler(client.ChimeSDKMessagingConn.Client, handler)}
+	}
+	if client.Cloud9Conn != nil {
+		client.Cloud9Conn = &cloud9.Cloud9{Client: withValidateHandler(client.Cloud9Conn.Client, handler)}
+	}
+	if client.CloudControlConn != nil {
+		client.CloudControlConn = &cloudcontrolapi.CloudControlApi{Client: withValidateHandler(client.CloudControlConn.Client, handler)}
+	}
+	if client.CloudDirectoryConn != nil {
+		client.CloudDirectoryConn = &clouddirectory.CloudDirectory{Client: withValidateHandler(client.CloudDirectoryConn.Client, handler)}
+	}
+	if client.CloudFormationConn != nil {
+		client.CloudFormationConn = &cloudformation.CloudFormation{Client: withValidateHandler(client.CloudFormationConn.Client, handler)}
+	}
+	if client.CloudFrontConn != nil {
+		client.CloudFrontConn = &cloudfront.CloudFront{Client: withValidateHandler(client.CloudFrontConn.Client, handler)}
+	}
+	if client.CloudHSMV2Conn != nil {
+		client.CloudHSMV2Conn = &cloudhsmv2.CloudHSMV2{Client: withValidateHandler(client.CloudHSMV2Conn.Client, handler)}
+	}
+	if client.CloudSearchConn != nil {
+		client.CloudSearchConn = &cloudsearch.CloudSearch{Client: withValidateHandler(client.CloudSearchConn.Client, handler)}
+	}
+	if client.CloudSearchDomainConn != nil {
+		client.CloudSearchDomainConn = &cloudsearchdomain.CloudSearchDomain{Client: withValidateHandler(client.CloudSearchDomainConn.Client, handler)}
+	}
+	if client.CloudTrailConn != nil {
+		client.CloudTrailConn = &cloudtrail.CloudTrail{Client: withValidateHandler(client.CloudTrailConn.Client, handler)}
+	}
+	if client.CloudWatchConn != nil {
+		client.CloudWatchConn = &cloudwatch.CloudWatch{Client: withValidateHandler(client.CloudWatchConn.Client, handler)}
+	}
+	if client.CodeArtifactConn != nil {
+		client.CodeArtifactConn = &codeartifact.CodeArtifact{Client: withValidateHandler(client.CodeArtifactConn.Client, handler)}
+	}
+	if client.CodeBuildConn != nil {
+		client.CodeBuildConn = &codebuild.CodeBuild{Client: withValidateHandler(client.CodeBuildConn.Client, handler)}
+	}
+	if client.CodeCommitConn != nil {
+		client.CodeCommitConn = &codecommit.CodeCommit{Client: withValidateHandler(client.CodeCommitConn.Client, handler)}
+	}
+	if client.CodeGuruProfilerConn != nil {
+		client.CodeGuruProfilerConn = &codeguruprofiler.CodeGuruProfiler{Client: withValidateHandler(client.CodeGuruProfilerConn.Client, handler)}
+	}
+	if client.CodeGuruReviewerConn != nil {
+		client.CodeGuruReviewerConn = &codegurureviewer.CodeGuruReviewer{Client: withValidateHandler(client.CodeGuruReviewerConn.Client, handler)}
+	}
+	if client.CodePipelineConn != nil {
+		client.CodePipelineConn = &codepipeline.CodePipeline{Client: withValidateHandler(client.CodePipelineConn.Client, handler)}
+	}
+	if client.CodeStarConn != nil {
+		client.CodeStarConn = &codestar.CodeStar{Client: withValidateHandler(client.CodeStarConn.Client, handler)}
+	}
+	if client.CodeStarConnectionsConn != nil {
+		client.CodeStarConnectionsConn = &codestarconnections.CodeStarConnections{Client: withValidateHandler(client.CodeStarConnectionsConn.Client, handler)}
+	}
+	if client.CodeStarNotificationsConn != nil {
+		client.CodeStarNotificationsConn = &codestarnotifications.CodeStarNotifications{Client: withValidateHandler(client.CodeStarNotificationsConn.Client, handler)}
+	}
+	if client.CognitoIDPConn != nil {
+		client.CognitoIDPConn = &cognitoidentityprovider.CognitoIdentityProvider{Client: withValidateHandler(client.CognitoIDPConn.Client, handler)}
+	}
+	if client.CognitoIdentityConn != nil {
+		client.CognitoIdentityConn = &cognitoidentity.CognitoIdentity{Client: withValidateHandler(client.CognitoIdentityConn.Client, handler)}
+	}
+	if client.CognitoSyncConn != nil {
+		client.CognitoSyncConn = &cognitosync.CognitoSync{Client: withValidateHandler(client.CognitoSyncConn.Client, handler)}
+	}
+	if client.ComprehendConn != nil {
+		client.ComprehendConn = &comprehend.Comprehend{Client: withValidateHandler(client.ComprehendConn.Client, handler)}
+	}
+	if client.ComprehendMedicalConn != nil {
+		client.ComprehendMedicalConn = &comprehendmedical.ComprehendMedical{Client: withValidateHandler(client.ComprehendMedicalConn.Client, handler)}
+	}
+	if client.ComputeOptimizerConn != nil {
+		client.ComputeOptimizerConn = &computeoptimizer.ComputeOptimizer{Client: withValidateHandler(client.ComputeOptimizerConn.Client, handler)}
+	}
+	if client.ConfigServiceConn != nil {
+		client.ConfigServiceConn = &configservice.ConfigService{Client: withValidateHandler(client.ConfigServiceConn.Client, handler)}
+	}
+	if client.ConnectConn != nil {
+		client.ConnectConn = &connect.Connect{Client: withValidateHandler(client.ConnectConn.Client, handler)}
+	}
+	if client.ConnectContactLensConn != nil {
+		client.ConnectContactLensConn = &connectcontactlens.ConnectContactLens{Client: withValidateHandler(client.ConnectContactLensConn.Client, handler)}
+	}
+	if client.ConnectParticipantConn != nil {
+		client.ConnectParticipantConn = &connectparticipant.ConnectParticipant{Client: withValidateHandler(client.ConnectParticipantConn.Client, handler)}
+	}
+	if client.CustomerProfilesConn != nil {
+		client.CustomerProfilesConn = &customerprofiles.CustomerProfiles{Client: withValidateHandler(client.CustomerProfilesConn.Client, handler)}
+	}
+	if client.DAXConn != nil {
+		client.DAXConn = &dax.DAX{Client: withValidateHandler(client.DAXConn.Client, handler)}
+	}
+	if client.DLMConn != nil {
+		client.DLMConn = &dlm.DLM{Client: withValidateHandler(client.DLMConn.Client, handler)}
+	}
+	if client.DMSConn != nil {
+		client.DMSConn = &databasemigrationservice.DatabaseMigrationService{Client: withValidateHandler(client.DMSConn.Client, handler)}
+	}
+	if client.DRSConn != nil {
+		client.DRSConn = &drs.Drs{Client: withValidateHandler(client.DRSConn.Client, handler)}
+	}
+	if client.DSConn != nil {
+		client.DSConn = &directoryservice.DirectoryService{Client: withValidateHandler(client.DSConn.Client, handler)}
+	}
+	if client.DataBrewConn != nil {
+		client.DataBrewConn = &gluedatabrew.GlueDataBrew{Client: withValidateHandler(client.DataBrewConn.Client, handler)}
+	}
+	if client.DataExchangeConn != nil {
+		client.DataExchangeConn = &dataexchange.DataExchange{Client: withValidateHandler(client.DataExchangeConn.Client, handler)}
+	}
+	if client.DataPipelineConn != nil {
+		client.DataPipelineConn = &datapipeline.DataPipeline{Client: withValidateHandler(client.DataPipelineConn.Client, handler)}
+	}
+	if client.DataSyncConn != nil {
+		client.DataSyncConn = &datasync.DataSync{Client: withValidateHandler(client.DataSyncConn.Client, handler)}
+	}
+	if client.DeployConn != nil {
+		client.DeployConn = &codedeploy.CodeDeploy{Client: withValidateHandler(client.DeployConn.Client, handler)}
+	}
+	if client.DetectiveConn != nil {
+		client.DetectiveConn = &detective.Detective{Client: withValidateHandler(client.DetectiveConn.Client, handler)}
+	}
+	if client.DevOpsGuruConn != nil {
+		client.DevOpsGuruConn = &devopsguru.DevOpsGuru{Client: withValidateHandler(client.DevOpsGuruConn.Client, handler)}
+	}
+	if client.DeviceFarmConn != nil {
+		client.DeviceFarmConn = &devicefarm.DeviceFarm{Client: withValidateHandler(client.DeviceFarmConn.Client, handler)}
+	}
+	if client.DirectConnectConn != nil {
+		client.DirectConnectConn = &directconnect.DirectConnect{Client: withValidateHandler(client.DirectConnectConn.Client, handler)}
+	}
+	if client.DiscoveryConn != nil {
+		client.DiscoveryConn = &applicationdiscoveryservice.ApplicationDiscoveryService{Client: withValidateHandler(client.DiscoveryConn.Client, handler)}
+	}
+	if client.DocDBConn != nil {
+		client.DocDBConn = &docdb.DocDB{Client: withValidateHandler(client.DocDBConn.Client, handler)}
+	}
+	if client.DynamoDBConn != nil {
+		client.DynamoDBConn = &dynamodb.DynamoDB{Client: withValidateHandler(client.DynamoDBConn.Client, handler)}
+	}
+	if client.DynamoDBStreamsConn != nil {
+		client.DynamoDBStreamsConn = &dynamodbstreams.DynamoDBStreams{Client: withValidateHandler(client.DynamoDBStreamsConn.Client, handler)}
+	}
+	if client.EBSConn != nil {
+		client.EBSConn = &ebs.EBS{Client: withValidateHandler(client.EBSConn.Client, handler)}
+	}
+	if client.EC2Conn != nil {
+		client.EC2Conn = &ec2.EC2{Client: withValidateHandler(client.EC2Conn.Client, handler)}
+	}
+	if client.EC2InstanceConnectConn != nil {
+		client.EC2InstanceConnectConn = &ec2instanceconnect.EC2InstanceConnect{Client: withValidateHandler(client.EC2InstanceConnectConn.Client, handler)}
+	}
+	if client.ECRConn != nil {
+		client.ECRConn = &ecr.ECR{Client: withValidateHandler(client.ECRConn.Client, handler)}
+	}
+	if client.ECRPublicConn != nil {
+		client.ECRPublicConn = &ecrpublic.ECRPublic{Client: withValidateHandler(client.ECRPublicConn.Client, handler)}
+	}
+	if client.ECSConn != nil {
+		client.ECSConn = &ecs.ECS{Client: withValidateHandler(client.ECSConn.Client, handler)}
+	}
+	if client.EFSConn != nil {
+		client.EFSConn = &efs.EFS{Client: withValidateHandler(client.EFSConn.Client, handler)}
+	}
+	if client.EKSConn != nil {
+		client.EKSConn = &eks.EKS{Client: withValidateHandler(client.EKSConn.Client, handler)}
+	}
+	if client.ELBConn != nil {
+		client.ELBConn = &elb.ELB{Client: withValidateHandler(client.ELBConn.Client, handler)}
+	}
+	if client.ELBV2Conn != nil {
+		client.ELBV2Conn = &elbv2.ELBV2{Client: withValidateHandler(client.ELBV2Conn.Client, handler)}
+	}
+	if client.EMRConn != nil {
+		client.EMRConn = &emr.EMR{Client: withValidateHandler(client.EMRConn.Client, handler)}
+	}
+	if client.EMRContainersConn != nil {
+		client.EMRContainersConn = &emrcontainers.EMRContainers{Client: withValidateHandler(client.EMRContainersConn.Client, handler)}
+	}
+	if client.EMRServerlessConn != nil {
+		client.EMRServerlessConn = &emrserverless.EMRServerless{Client: withValidateHandler(client.EMRServerlessConn.Client, handler)}
+	}
+	if client.ElastiCacheConn != nil {
+		client.ElastiCacheConn = &elasticache.ElastiCache{Client: withValidateHandler(client.ElastiCacheConn.Client, handler)}
+	}
+	if client.ElasticBeanstalkConn != nil {
+		client.ElasticBeanstalkConn = &elasticbeanstalk.ElasticBeanstalk{Client: withValidateHandler(client.ElasticBeanstalkConn.Client, handler)}
+	}
+	if client.ElasticInferenceConn != nil {
+		client.ElasticInferenceConn = &elasticinference.ElasticInference{Client: withValidateHandler(client.ElasticInferenceConn.Client, handler)}
+	}
+	if client.ElasticTranscoderConn != nil {
+		client.ElasticTranscoderConn = &elastictranscoder.ElasticTranscoder{Client: withValidateHandler(client.ElasticTranscoderConn.Client, handler)}
+	}
+	if client.ElasticsearchConn != nil {
+		client.ElasticsearchConn = &elasticsearchservice.ElasticsearchService{Client: withValidateHandler(client.ElasticsearchConn.Client, handler)}
+	}
+	if client.EventsConn != nil {
+		client.EventsConn = &eventbridge.EventBridge{Client: withValidateHandler(client.EventsConn.Client, handler)}
+	}
+	if client.EvidentlyConn != nil {
+		client.EvidentlyConn = &cloudwatchevidently.CloudWatchEvidently{Client: withValidateHandler(client.EvidentlyConn.Client, handler)}
+	}
+	if client.FISConn != nil {
+		client.FISConn = &fis.FIS{Client: withValidateHandler(client.FISConn.Client, handler)}
+	}
+	if client.FMSConn != nil {
+		client.FMSConn = &fms.FMS{Client: withValidateHandler(client.FMSConn.Client, handler)}
+	}
+	if client.FSxConn != nil {
+		client.FSxConn = &fsx.FSx{Client: withValidateHandler(client.FSxConn.Client, handler)}
+	}
+	if client.FinSpaceConn != nil {
+		client.FinSpaceConn = &finspace.Finspace{Client: withValidateHandler(client.FinSpaceConn.Client, handler)}
+	}
+	if client.FinSpaceDataConn != nil {
+		client.FinSpaceDataConn = &finspacedata.FinSpaceData{Client: withValidateHandler(client.FinSpaceDataConn.Client, handler)}
+	}
+	if client.FirehoseConn != nil {
+		client.FirehoseConn = &firehose.Firehose{Client: withValidateHandler(client.FirehoseConn.Client, handler)}
+	}
+	if client.ForecastConn != nil {
+		client.ForecastConn = &forecastservice.ForecastService{Client: withValidateHandler(client.ForecastConn.Client, handler)}
+	}
+	if client.ForecastQueryConn != nil {
+		client.ForecastQueryConn = &forecastqueryservice.ForecastQueryService{Client: withValidateHandler(client.ForecastQueryConn.Client, handler)}
+	}
+	if client.FraudDetectorConn != nil {
+		client.FraudDetectorConn = &frauddetector.FraudDetector{Client: withValidateHandler(client.FraudDetectorConn.Client, handler)}
+	}
+	if client.GameLiftConn != nil {
+		client.GameLiftConn = &gamelift.GameLift{Client: withValidateHandler(client.GameLiftConn.Client, handler)}
+	}
+	if client.GlacierConn != nil {
+		client.GlacierConn = &glacier.Glacier{Client: withValidateHandler(client.GlacierConn.Client, handler)}
+	}
+	if client.GlobalAcceleratorConn != nil {
+		client.GlobalAcceleratorConn = &globalaccelerator.GlobalAccelerator{Client: withValidateHandler(client.GlobalAcceleratorConn.Client, handler)}
+	}
+	if client.GlueConn != nil {
+		client.GlueConn = &glue.Glue{Client: withValidateHandler(client.GlueConn.Client, handler)}
+	}
+	if client.GrafanaConn != nil {
+		client.GrafanaConn = &managedgrafana.ManagedGrafana{Client: withValidateHandler(client.GrafanaConn.Client, handler)}
+	}
+	if client.GreengrassConn != nil {
+		client.GreengrassConn = &greengrass.Greengrass{Client: withValidateHandler(client.GreengrassConn.Client, handler)}
+	}
+	if client.GreengrassV2Conn != nil {
+		client.GreengrassV2Conn = &greengrassv2.GreengrassV2{Client: withValidateHandler(client.GreengrassV2Conn.Client, handler)}
+	}
+	if client.GroundStationConn != nil {
+		client.GroundStationConn = &groundstation.GroundStation{Client: withValidateHandler(client.GroundStationConn.Client, handler)}
+	}
+	if client.GuardDutyConn != nil {
+		client.GuardDutyConn = &guardduty.GuardDuty{Client: withValidateHandler(client.GuardDutyConn.Client, handler)}
+	}
+	if client.HealthConn != nil {
+		client.HealthConn = &health.Health{Client: withValidateHandler(client.HealthConn.Client, handler)}
+	}
+	if client.HealthLakeConn != nil {
+		client.HealthLakeConn = &healthlake.HealthLake{Client: withValidateHandler(client.HealthLakeConn.Client, handler)}
+	}
+	if client.HoneycodeConn != nil {
+		client.HoneycodeConn = &honeycode.Honeycode{Client: withValidateHandler(client.HoneycodeConn.Client, handler)}
+	}
+	if client.IAMConn != nil {
+		client.IAMConn = &iam.IAM{Client: withValidateHandler(client.IAMConn.Client, handler)}
+	}
+	if client.IVSConn != nil {
+		client.IVSConn = &ivs.IVS{Client: withValidateHandler(client.IVSConn.Client, handler)}
+	}
+	if client.IdentityStoreConn != nil {
+		client.IdentityStoreConn = &identitystore.IdentityStore{Client: withValidateHandler(client.IdentityStoreConn.Client, handler)}
+	}
+	if client.ImageBuilderConn != nil {
+		client.ImageBuilderConn = &imagebuilder.Imagebuilder{Client: withValidateHandler(client.ImageBuilderConn.Client, handler)}
+	}
+	if client.InspectorConn != nil {
+		client.InspectorConn = &inspector.Inspector{Client: withValidateHandler(client.InspectorConn.Client, handler)}
+	}
+	if client.Inspector2Conn != nil {
+		client.Inspector2Conn = &inspector2.Inspector2{Client: withValidateHandler(client.Inspector2Conn.Client, handler)}
+	}
+	if client.IoTConn != nil {
+		client.IoTConn = &iot.IoT{Client: withValidateHandler(client.IoTConn.Client, handler)}
+	}
+	if client.IoT1ClickDevicesConn != nil {
+		client.IoT1ClickDevicesConn = &iot1clickdevicesservice.IoT1ClickDevicesService{Client: withValidateHandler(client.IoT1ClickDevicesConn.Client, handler)}
+	}
+	if client.IoT1ClickProjectsConn != nil {
+		client.IoT1ClickProjectsConn = &iot1clickprojects.IoT1ClickProjects{Client: withValidateHandler(client.IoT1ClickProjectsConn.Client, handler)}
+	}
+	if client.IoTAnalyticsConn != nil {
+		client.IoTAnalyticsConn = &iotanalytics.IoTAnalytics{Client: withValidateHandler(client.IoTAnalyticsConn.Client, handler)}
+	}
+	if client.IoTDataConn != nil {
+		client.IoTDataConn = &iotdataplane.IoTDataPlane{Client: withValidateHandler(client.IoTDataConn.Client, handler)}
+	}
+	if client.IoTDeviceAdvisorConn != nil {
+		client.IoTDeviceAdvisorConn = &iotdeviceadvisor.IoTDeviceAdvisor{Client: withValidateHandler(client.IoTDeviceAdvisorConn.Client, handler)}
+	}
+	if client.IoTEventsConn != nil {
+		client.IoTEventsConn = &iotevents.IoTEvents{Client: withValidateHandler(client.IoTEventsConn.Client, handler)}
+	}
+	if client.IoTEventsDataConn != nil {
+		client.IoTEventsDataConn = &ioteventsdata.IoTEventsData{Client: withValidateHandler(client.IoTEventsDataConn.Client, handler)}
+	}
+	if client.IoTFleetHubConn != nil {
+		client.IoTFleetHubConn = &iotfleethub.IoTFleetHub{Client: withValidateHandler(client.IoTFleetHubConn.Client, handler)}
+	}
+	if client.IoTJobsDataConn != nil {
+		client.IoTJobsDataConn = &iotjobsdataplane.IoTJobsDataPlane{Client: withValidateHandler(client.IoTJobsDataConn.Client, handler)}
+	}
+	if client.IoTSecureTunnelingConn != nil {
+		client.IoTSecureTunnelingConn = &iotsecuretunneling.IoTSecureTunneling{Client: withValidateHandler(client.IoTSecureTunnelingConn.Client, handler)}
+	}
+	if client.IoTSiteWiseConn != nil {
+		client.IoTSiteWiseConn = &iotsitewise.IoTSiteWise{Client: withValidateHandler(client.IoTSiteWiseConn.Client, handler)}
+	}
+	if client.IoTThingsGraphConn != nil {
+		client.IoTThingsGraphConn = &iotthingsgraph.IoTThingsGraph{Client: withValidateHandler(client.IoTThingsGraphConn.Client, handler)}
+	}
+	if client.IoTTwinMakerConn != nil {
+		client.IoTTwinMakerConn = &iottwinmaker.IoTTwinMaker{Client: withValidateHandler(client.IoTTwinMakerConn.Client, handler)}
+	}
+	if client.IoTWirelessConn != nil {
+		client.IoTWirelessConn = &iotwireless.IoTWireless{Client: withValidateHandler(client.IoTWirelessConn.Client, handler)}
+	}
+	if client.KMSConn != nil {
+		client.KMSConn = &kms.KMS{Client: withValidateHandler(client.KMSConn.Client, handler)}
+	}
+	if client.KafkaConn != nil {
+		client.KafkaConn = &kafka.Kafka{Client: withValidateHandler(client.KafkaConn.Client, handler)}
+	}
+	if client.KafkaConnectConn != nil {
+		client.KafkaConnectConn = &kafkaconnect.KafkaConnect{Client: withValidateHandler(client.KafkaConnectConn.Client, handler)}
+	}
+	if client.KeyspacesConn != nil {
+		client.KeyspacesConn = &keyspaces.Keyspaces{Client: withValidateHandler(client.KeyspacesConn.Client, handler)}
+	}
+	if client.KinesisConn != nil {
+		client.KinesisConn = &kinesis.Kinesis{Client: withValidateHandler(client.KinesisConn.Client, handler)}
+	}
+	if client.KinesisAnalyticsConn != nil {
+		client.KinesisAnalyticsConn = &kinesisanalytics.KinesisAnalytics{Client: withValidateHandler(client.KinesisAnalyticsConn.Client, handler)}
+	}
+	if client.KinesisAnalyticsV2Conn != nil {
+		client.KinesisAnalyticsV2Conn = &kinesisanalyticsv2.KinesisAnalyticsV2{Client: withValidateHandler(client.KinesisAnalyticsV2Conn.Client, handler)}
+	}
+	if client.KinesisVideoConn != nil {
+		client.KinesisVideoConn = &kinesisvideo.KinesisVideo{Client: withValidateHandler(client.KinesisVideoConn.Client, handler)}
+	}
+	if client.KinesisVideoArchivedMediaConn != nil {
+		client.KinesisVideoArchivedMediaConn = &kinesisvideoarchivedmedia.KinesisVideoArchivedMedia{Client: withValidateHandler(client.KinesisVideoArchivedMediaConn.Client, handler)}
+	}
+	if client.KinesisVideoMediaConn != nil {
+		client.KinesisVideoMediaConn = &kinesisvideomedia.KinesisVideoMedia{Client: withValidateHandler(client.KinesisVideoMediaConn.Client, handler)}
+	}
+	if client.KinesisVideoSignalingConn != nil {
+		client.KinesisVideoSignalingConn = &kinesisvideosignalingchannels.KinesisVideoSignalingChannels{Client: withValidateHandler(client.KinesisVideoSignalingConn.Client, handler)}
+	}
+	if client.LakeFormationConn != nil {
+		client.LakeFormationConn = &lakeformation.LakeFormation{Client: withValidateHandler(client.LakeFormationConn.Client, handler)}
+	}
+	if client.LambdaConn != nil {
+		client.LambdaConn = &lambda.Lambda{Client: withValidateHandler(client.LambdaConn.Client, handler)}
+	}
+	if client.LexModelsConn != nil {
+		client.LexModelsConn = &lexmodelbuildingservice.LexModelBuildingService{Client: withValidateHandler(client.LexModelsConn.Client, handler)}
+	}
+	if client.LexModelsV2Conn != nil {
+		client.LexModelsV2Conn = &lexmodelsv2.LexModelsV2{Client: withValidateHandler(client.LexModelsV2Conn.Client, handler)}
+	}
+	if client.LexRuntimeConn != nil {
+		client.LexRuntimeConn = &lexruntimeservice.LexRuntimeService{Client: withValidateHandler(client.LexRuntimeConn.Client, handler)}
+	}
+	if client.LexRuntimeV2Conn != nil {
+		client.LexRuntimeV2Conn = &lexruntimev2.LexRuntimeV2{Client: withValidateHandler(client.LexRuntimeV2Conn.Client, handler)}
+	}
+	if client.LicenseManagerConn != nil {
+		client.LicenseManagerConn = &licensemanager.LicenseManager{Client: withValidateHandler(client.LicenseManagerConn.Client, handler)}
+	}
+	if client.LightsailConn != nil {
+		client.LightsailConn = &lightsail.Lightsail{Client: withValidateHandler(client.LightsailConn.Client, handler)}
+	}
+	if client.LocationConn != nil {
+		client.LocationConn = &locationservice.LocationService{Client: withValidateHandler(client.LocationConn.Client, handler)}
+	}
+	if client.LogsConn != nil {
+		client.LogsConn = &cloudwatchlogs.CloudWatchLogs{Client: withValidateHandler(client.LogsConn.Client, handler)}
+	}
+	if client.LookoutEquipmentConn != nil {
+		client.LookoutEquipmentConn = &lookoutequipment.LookoutEquipment{Client: withValidateHandler(client.LookoutEquipmentConn.Client, handler)}
+	}
+	if client.LookoutMetricsConn != nil {
+		client.LookoutMetricsConn = &lookoutmetrics.LookoutMetrics{Client: withValidateHandler(client.LookoutMetricsConn.Client, handler)}
+	}
+	if client.LookoutVisionConn != nil {
+		client.LookoutVisionConn = &lookoutforvision.LookoutForVision{Client: withValidateHandler(client.LookoutVisionConn.Client, handler)}
+	}
+	if client.MQConn != nil {
+		client.MQConn = &mq.MQ{Client: withValidateHandler(client.MQConn.Client, handler)}
+	}
+	if client.MTurkConn != nil {
+		client.MTurkConn = &mturk.MTurk{Client: withValidateHandler(client.MTurkConn.Client, handler)}
+	}
+	if client.MWAAConn != nil {
+		client.MWAAConn = &mwaa.MWAA{Client: withValidateHandler(client.MWAAConn.Client, handler)}
+	}
+	if client.MachineLearningConn != nil {
+		client.MachineLearningConn = &machinelearning.MachineLearning{Client: withValidateHandler(client.MachineLearningConn.Client, handler)}
+	}
+	if client.MacieConn != nil {
+		client.MacieConn = &macie.Macie{Client: withValidateHandler(client.MacieConn.Client, handler)}
+	}
+	if client.Macie2Conn != nil {
+		client.Macie2Conn = &macie2.Macie2{Client: withValidateHandler(client.Macie2Conn.Client, handler)}
+	}
+	if client.ManagedBlockchainConn != nil {
+		client.ManagedBlockchainConn = &managedblockchain.ManagedBlockchain{Client: withValidateHandler(client.ManagedBlockchainConn.Client, handler)}
+	}
+	if client.MarketplaceCatalogConn != nil {
+		client.MarketplaceCatalogConn = &marketplacecatalog.MarketplaceCatalog{Client: withValidateHandler(client.MarketplaceCatalogConn.Client, handler)}
+	}
+	if client.MarketplaceCommerceAnalyticsConn != nil {
+		client.MarketplaceCommerceAnalyticsConn = &marketplacecommerceanalytics.MarketplaceCommerceAnalytics{Client: withValidateHandler(client.MarketplaceCommerceAnalyticsConn.Client, handler)}
+	}
+	if client.MarketplaceEntitlementConn != nil {
+		client.MarketplaceEntitlementConn = &marketplaceentitlementservice.MarketplaceEntitlementService{Client: withValidateHandler(client.MarketplaceEntitlementConn.Client, handler)}
+	}
+	if client.MarketplaceMeteringConn != nil {
+		client.MarketplaceMeteringConn = &marketplacemetering.MarketplaceMetering{Client: withValidateHandler(client.MarketplaceMeteringConn.Client, handler)}
+	}
+	if client.MediaConnectConn != nil {
+		client.MediaConnectConn = &mediaconnect.MediaConnect{Client: withValidateHandler(client.MediaConnectConn.Client, handler)}
+	}
+	if client.MediaConvertConn != nil {
+		client.MediaConvertConn = &mediaconvert.MediaConvert{Client: withValidateHandler(client.MediaConvertConn.Client, handler)}
+	}
+	if client.MediaLiveConn != nil {
+		client.MediaLiveConn = &medialive.MediaLive{Client: withValidateHandler(client.MediaLiveConn.Client, handler)}
+	}
+	if client.MediaPackageConn != nil {
+		client.MediaPackageConn = &mediapackage.MediaPackage{Client: withValidateHandler(client.MediaPackageConn.Client, handler)}
+	}
+	if client.MediaPackageVODConn != nil {
+		client.MediaPackageVODConn = &mediapackagevod.MediaPackageVod{Client: withValidateHandler(client.MediaPackageVODConn.Client, handler)}
+	}
+	if client.MediaStoreConn != nil {
+		client.MediaStoreConn = &mediastore.MediaStore{Client: withValidateHandler(client.MediaStoreConn.Client, handler)}
+	}
+	if client.MediaStoreDataConn != nil {
+		client.MediaStoreDataConn = &mediastoredata.MediaStoreData{Client: withValidateHandler(client.MediaStoreDataConn.Client, handler)}
+	}
+	if client.MediaTailorConn != nil {
+		client.MediaTailorConn = &mediatailor.MediaTailor{Client: withValidateHandler(client.MediaTailorConn.Client, handler)}
+	}
+	if client.MemoryDBConn != nil {
+		client.MemoryDBConn = &memorydb.MemoryDB{Client: withValidateHandler(client.MemoryDBConn.Client, handler)}
+	}
+	if client.MgHConn != nil {
+		client.MgHConn = &migrationhub.MigrationHub{Client: withValidateHandler(client.MgHConn.Client, handler)}
+	}
+	if client.MgnConn != nil {
+		client.MgnConn = &mgn.Mgn{Client: withValidateHandler(client.MgnConn.Client, handler)}
+	}
+	if client.MigrationHubConfigConn != nil {
+		client.MigrationHubConfigConn = &migrationhubconfig.MigrationHubConfig{Client: withValidateHandler(client.MigrationHubConfigConn.Client, handler)}
+	}
+	if client.MigrationHubRefactorSpacesConn != nil {
+		client.MigrationHubRefactorSpacesConn = &migrationhubrefactorspaces.MigrationHubRefactorSpaces{Client: withValidateHandler(client.MigrationHubRefactorSpacesConn.Client, handler)}
+	}
+	if client.MigrationHubStrategyConn != nil {
+		client.MigrationHubStrategyConn = &migrationhubstrategyrecommendations.MigrationHubStrategyRecommendations{Client: withValidateHandler(client.MigrationHubStrategyConn.Client, handler)}
+	}
+	if client.MobileConn != nil {
+		client.MobileConn = &mobile.Mobile{Client: withValidateHandler(client.MobileConn.Client, handler)}
+	}
+	if client.NeptuneConn != nil {
+		client.NeptuneConn = &neptune.Neptune{Client: withValidateHandler(client.NeptuneConn.Client, handler)}
+	}
+	if client.NetworkFirewallConn != nil {
+		client.NetworkFirewallConn = &networkfirewall.NetworkFirewall{Client: withValidateHandler(client.NetworkFirewallConn.Client, handler)}
+	}
+	if client.NetworkManagerConn != nil {
+		client.NetworkManagerConn = &networkmanager.NetworkManager{Client: withValidateHandler(client.NetworkManagerConn.Client, handler)}
+	}
+	if client.NimbleConn != nil {
+		client.NimbleConn = &nimblestudio.NimbleStudio{Client: withValidateHandler(client.NimbleConn.Client, handler)}
+	}
+	if client.OpenSearchConn != nil {
+		client.OpenSearchConn = &opensearchservice.OpenSearchService{Client: withValidateHandler(client.OpenSearchConn.Client, handler)}
+	}
+	if client.OpsWorksConn != nil {
+		client.OpsWorksConn = &opsworks.OpsWorks{Client: withValidateHandler(client.OpsWorksConn.Client, handler)}
+	}
+	if client.OpsWorksCMConn != nil {
+		client.OpsWorksCMConn = &opsworkscm.OpsWorksCM{Client: withValidateHandler(client.OpsWorksCMConn.Client, handler)}
+	}
+	if client.OrganizationsConn != nil {
+		client.OrganizationsConn = &organizations.Organizations{Client: withValidateHandler(client.OrganizationsConn.Client, handler)}
+	}
+	if client.OutpostsConn != nil {
+		client.OutpostsConn = &outposts.Outposts{Client: withValidateHandler(client.OutpostsConn.Client, handler)}
+	}
+	if client.PIConn != nil {
+		client.PIConn = &pi.PI{Client: withValidateHandler(client.PIConn.Client, handler)}
+	}
+	if client.PanoramaConn != nil {
+		client.PanoramaConn = &panorama.Panorama{Client: withValidateHandler(client.PanoramaConn.Client, handler)}
+	}
+	if client.PersonalizeConn != nil {
+		client.PersonalizeConn = &personalize.Personalize{Client: withValidateHandler(client.PersonalizeConn.Client, handler)}
+	}
+	if client.PersonalizeEventsConn != nil {
+		client.PersonalizeEventsConn = &personalizeevents.PersonalizeEvents{Client: withValidateHandler(client.PersonalizeEventsConn.Client, handler)}
+	}
+	if client.PersonalizeRuntimeConn != nil {
+		client.PersonalizeRuntimeConn = &personalizeruntime.PersonalizeRuntime{Client: withValidateHandler(client.PersonalizeRuntimeConn.Client, handler)}
+	}
+	if client.PinpointConn != nil {
+		client.PinpointConn = &pinpoint.Pinpoint{Client: withValidateHandler(client.PinpointConn.Client, handler)}
+	}
+	if client.PinpointEmailConn != nil {
+		client.PinpointEmailConn = &pinpointemail.PinpointEmail{Client: withValidateHandler(client.PinpointEmailConn.Client, handler)}
+	}
+	if client.PinpointSMSVoiceConn != nil {
+		client.PinpointSMSVoiceConn = &pinpointsmsvoice.PinpointSMSVoice{Client: withValidateHandler(client.PinpointSMSVoiceConn.Client, handler)}
+	}
+	if client.PollyConn != nil {
+		client.PollyConn = &polly.Polly{Client: withValidateHandler(client.PollyConn.Client, handler)}
+	}
+	if client.PricingConn != nil {
+		client.PricingConn = &pricing.Pricing{Client: withValidateHandler(client.PricingConn.Client, handler)}
+	}
+	if client.ProtonConn != nil {
+		client.ProtonConn = &proton.Proton{Client: withValidateHandler(client.ProtonConn.Client, handler)}
+	}
+	if client.QLDBConn != nil {
+		client.QLDBConn = &qldb.QLDB{Client: withValidateHandler(client.QLDBConn.Client, handler)}
+	}
+	if client.QLDBSessionConn != nil {
+		client.QLDBSessionConn = &qldbsession.QLDBSession{Client: withValidateHandler(client.QLDBSessionConn.Client, handler)}
+	}
+	if client.QuickSightConn != nil {
+		client.QuickSightConn = &quicksight.QuickSight{Client: withValidateHandler(client.QuickSightConn.Client, handler)}
+	}
+	if client.RAMConn != nil {
+		client.RAMConn = &ram.RAM{Client: withValidateHandler(client.RAMConn.Client, handler)}
+	}
+	if client.RBinConn != nil {
+		client.RBinConn = &recyclebin.RecycleBin{Client: withValidateHandler(client.RBinConn.Client, handler)}
+	}
+	if client.RDSConn != nil {
+		client.RDSConn = &rds.RDS{Client: withValidateHandler(client.RDSConn.Client, handler)}
+	}
+	if client.RDSDataConn != nil {
+		client.RDSDataConn = &rdsdataservice.RDSDataService{Client: withValidateHandler(client.RDSDataConn.Client, handler)}
+	}
+	if client.RUMConn != nil {
+		client.RUMConn = &cloudwatchrum.CloudWatchRUM{Client: withValidateHandler(client.RUMConn.Client, handler)}
+	}
+	if client.RedshiftConn != nil {
+		client.RedshiftConn = &redshift.Redshift{Client: withValidateHandler(client.RedshiftConn.Client, handler)}
+	}
+	if client.RedshiftDataConn != nil {
+		client.RedshiftDataConn = &redshiftdataapiservice.RedshiftDataAPIService{Client: withValidateHandler(client.RedshiftDataConn.Client, handler)}
+	}
+	if client.RekognitionConn != nil {
+		client.RekognitionConn = &rekognition.Rekognition{Client: withValidateHandler(client.RekognitionConn.Client, handler)}
+	}
+	if client.ResilienceHubConn != nil {
+		client.ResilienceHubConn = &resiliencehub.ResilienceHub{Client: withValidateHandler(client.ResilienceHubConn.Client, handler)}
+	}
+	if client.ResourceGroupsConn != nil {
+		client.ResourceGroupsConn = &resourcegroups.ResourceGroups{Client: withValidateHandler(client.ResourceGroupsConn.Client, handler)}
+	}
+	if client.ResourceGroupsTaggingAPIConn != nil {
+		client.ResourceGroupsTaggingAPIConn = &resourcegroupstaggingapi.ResourceGroupsTaggingAPI{Client: withValidateHandler(client.ResourceGroupsTaggingAPIConn.Client, handler)}
+	}
+	if client.RoboMakerConn != nil {
+		client.RoboMakerConn = &robomaker.RoboMaker{Client: withValidateHandler(client.RoboMakerConn.Client, handler)}
+	}
+	if client.Route53Conn != nil {
+		client.Route53Conn = &route53.Route53{Client: withValidateHandler(client.Route53Conn.Client, handler)}
+	}
+	if client.Route53RecoveryClusterConn != nil {
+		client.Route53RecoveryClusterConn = &route53recoverycluster.Route53RecoveryCluster{Client: withValidateHandler(client.Route53RecoveryClusterConn.Client, handler)}
+	}
+	if client.Route53RecoveryControlConfigConn != nil {
+		client.Route53RecoveryControlConfigConn = &route53recoverycontrolconfig.Route53RecoveryControlConfig{Client: withValidateHandler(client.Route53RecoveryControlConfigConn.Client, handler)}
+	}
+	if client.Route53RecoveryReadinessConn != nil {
+		client.Route53RecoveryReadinessConn = &route53recoveryreadiness.Route53RecoveryReadiness{Client: withValidateHandler(client.Route53RecoveryReadinessConn.Client, handler)}
+	}
+	if client.Route53ResolverConn != nil {
+		client.Route53ResolverConn = &route53resolver.Route53Resolver{Client: withValidateHandler(client.Route53ResolverConn.Client, handler)}
+	}
+	if client.S3Conn != nil {
+		client.S3Conn = &s3.S3{Client: withValidateHandler(client.S3Conn.Client, handler)}
+	}
+	if client.S3ControlConn != nil {
+		client.S3ControlConn = &s3control.S3Control{Client: withValidateHandler(client.S3ControlConn.Client, handler)}
+	}
+	if client.S3OutpostsConn != nil {
+		client.S3OutpostsConn = &s3outposts.S3Outposts{Client: withValidateHandler(client.S3OutpostsConn.Client, handler)}
+	}
+	if client.SESConn != nil {
+		client.SESConn = &ses.SES{Client: withValidateHandler(client.SESConn.Client, handler)}
+	}
+	if client.SESV2Conn != nil {
+		client.SESV2Conn = &sesv2.SESV2{Client: withValidateHandler(client.SESV2Conn.Client, handler)}
+	}
+	if client.SFNConn != nil {
+		client.SFNConn = &sfn.SFN{Client: withValidateHandler(client.SFNConn.Client, handler)}
+	}
+	if client.SMSConn != nil {
+		client.SMSConn = &sms.SMS{Client: withValidateHandler(client.SMSConn.Client, handler)}
+	}
+	if client.SNSConn != nil {
+		client.SNSConn = &sns.SNS{Client: withValidateHandler(client.SNSConn.Client, handler)}
+	}
+	if client.SQSConn != nil {
+		client.SQSConn = &sqs.SQS{Client: withValidateHandler(client.SQSConn.Client, handler)}
+	}
+	if client.SSMConn != nil {
+		client.SSMConn = &ssm.SSM{Client: withValidateHandler(client.SSMConn.Client, handler)}
+	}
+	if client.SSMContactsConn != nil {
+		client.SSMContactsConn = &ssmcontacts.SSMContacts{Client: withValidateHandler(client.SSMContactsConn.Client, handler)}
+	}
+	if client.SSMIncidentsConn != nil {
+		client.SSMIncidentsConn = &ssmincidents.SSMIncidents{Client: withValidateHandler(client.SSMIncidentsConn.Client, handler)}
+	}
+	if client.SSOConn != nil {
+		client.SSOConn = &sso.SSO{Client: withValidateHandler(client.SSOConn.Client, handler)}
+	}
+	if client.SSOAdminConn != nil {
+		client.SSOAdminConn = &ssoadmin.SSOAdmin{Client: withValidateHandler(client.SSOAdminConn.Client, handler)}
+	}
+	if client.SSOOIDCConn != nil {
+		client.SSOOIDCConn = &ssooidc.SSOOIDC{Client: withValidateHandler(client.SSOOIDCConn.Client, handler)}
+	}
+	if client.STSConn != nil {
+		client.STSConn = &sts.STS{Client: withValidateHandler(client.STSConn.Client, handler)}
+	}
+	if client.SWFConn != nil {
+		client.SWFConn = &swf.SWF{Client: withValidateHandler(client.SWFConn.Client, handler)}
+	}
+	if client.SageMakerConn != nil {
+		client.SageMakerConn = &sagemaker.SageMaker{Client: withValidateHandler(client.SageMakerConn.Client, handler)}
+	}
+	if client.SageMakerA2IRuntimeConn != nil {
+		client.SageMakerA2IRuntimeConn = &augmentedairuntime.AugmentedAIRuntime{Client: withValidateHandler(client.SageMakerA2IRuntimeConn.Client, handler)}
+	}
+	if client.SageMakerEdgeConn != nil {
+		client.SageMakerEdgeConn = &sagemakeredgemanager.SagemakerEdgeManager{Client: withValidateHandler(client.SageMakerEdgeConn.Client, handler)}
+	}
+	if client.SageMakerFeatureStoreRuntimeConn != nil {
+		client.SageMakerFeatureStoreRuntimeConn = &sagemakerfeaturestoreruntime.SageMakerFeatureStoreRuntime{Client: withValidateHandler(client.SageMakerFeatureStoreRuntimeConn.Client, handler)}
+	}
+	if client.SageMakerRuntimeConn != nil {
+		client.SageMakerRuntimeConn = &sagemakerruntime.SageMakerRuntime{Client: withValidateHandler(client.SageMakerRuntimeConn.Client, handler)}
+	}
+	if client.SavingsPlansConn != nil {
+		client.SavingsPlansConn = &savingsplans.SavingsPlans{Client: withValidateHandler(client.SavingsPlansConn.Client, handler)}
+	}
+	if client.SchemasConn != nil {
+		client.SchemasConn = &schemas.Schemas{Client: withValidateHandler(client.SchemasConn.Client, handler)}
+	}
+	if client.SecretsManagerConn != nil {
+		client.SecretsManagerConn = &secretsmanager.SecretsManager{Client: withValidateHandler(client.SecretsManagerConn.Client, handler)}
+	}
+	if client.SecurityHubConn != nil {
+		client.SecurityHubConn = &securityhub.SecurityHub{Client: withValidateHandler(client.SecurityHubConn.Client, handler)}
+	}
+	if client.ServerlessRepoConn != nil {
+		client.ServerlessRepoConn = &serverlessapplicationrepository.ServerlessApplicationRepository{Client: withValidateHandler(client.ServerlessRepoConn.Client, handler)}
+	}
+	if client.ServiceCatalogConn != nil {
+		client.ServiceCatalogConn = &servicecatalog.ServiceCatalog{Client: withValidateHandler(client.ServiceCatalogConn.Client, handler)}
+	}
+	if client.ServiceCatalogAppRegistryConn != nil {
+		client.ServiceCatalogAppRegistryConn = &appregistry.AppRegistry{Client: withValidateHandler(client.ServiceCatalogAppRegistryConn.Client, handler)}
+	}
+	if client.ServiceDiscoveryConn != nil {
+		client.ServiceDiscoveryConn = &servicediscovery.ServiceDiscovery{Client: withValidateHandler(client.ServiceDiscoveryConn.Client, handler)}
+	}
+	if client.ServiceQuotasConn != nil {
+		client.ServiceQuotasConn = &servicequotas.ServiceQuotas{Client: withValidateHandler(client.ServiceQuotasConn.Client, handler)}
+	}
+	if client.ShieldConn != nil {
+		client.ShieldConn = &shield.Shield{Client: withValidateHandler(client.ShieldConn.Client, handler)}
+	}
+	if client.SignerConn != nil {
+		client.SignerConn = &signer.Signer{Client: withValidateHandler(client.SignerConn.Client, handler)}
+	}
+	if client.SimpleDBConn != nil {
+		client.SimpleDBConn = &simpledb.SimpleDB{Client: withValidateHandler(client.SimpleDBConn.Client, handler)}
+	}
+	if client.SnowDeviceManagementConn != nil {
+		client.SnowDeviceManagementConn = &snowdevicemanagement.SnowDeviceManagement{Client: withValidateHandler(client.SnowDeviceManagementConn.Client, handler)}
+	}
+	if client.SnowballConn != nil {
+		client.SnowballConn = &snowball.Snowball{Client: withValidateHandler(client.SnowballConn.Client, handler)}
+	}
+	if client.StorageGatewayConn != nil {
+		client.StorageGatewayConn = &storagegateway.StorageGateway{Client: withValidateHandler(client.StorageGatewayConn.Client, handler)}
+	}
+	if client.SupportConn != nil {
+		client.SupportConn = &support.Support{Client: withValidateHandler(client.SupportConn.Client, handler)}
+	}
+	if client.SyntheticsConn != nil {
+		client.SyntheticsConn = &synthetics.Synthetics{Client: withValidateHandler(client.SyntheticsConn.Client, handler)}
+	}
+	if client.TextractConn != nil {
+		client.TextractConn = &textract.Textract{Client: withValidateHandler(client.TextractConn.Client, handler)}
+	}
+	if client.TimestreamQueryConn != nil {
+		client.TimestreamQueryConn = &timestreamquery.TimestreamQuery{Client: withValidateHandler(client.TimestreamQueryConn.Client, handler)}
+	}
+	if client.TimestreamWriteConn != nil {
+		client.TimestreamWriteConn = &timestreamwrite.TimestreamWrite{Client: withValidateHandler(client.TimestreamWriteConn.Client, handler)}
+	}
+	if client.TranscribeConn != nil {
+		client.TranscribeConn = &transcribeservice.TranscribeService{Client: withValidateHandler(client.TranscribeConn.Client, handler)}
+	}
+	if client.TranscribeStreamingConn != nil {
+		client.TranscribeStreamingConn = &transcribestreamingservice.TranscribeStreamingService{Client: withValidateHandler(client.TranscribeStreamingConn.Client, handler)}
+	}
+	if client.TransferConn != nil {
+		client.TransferConn = &transfer.Transfer{Client: withValidateHandler(client.TransferConn.Client, handler)}
+	}
+	if client.TranslateConn != nil {
+		client.TranslateConn = &translate.Translate{Client: withValidateHandler(client.TranslateConn.Client, handler)}
+	}
+	if client.VoiceIDConn != nil {
+		client.VoiceIDConn = &voiceid.VoiceID{Client: withValidateHandler(client.VoiceIDConn.Client, handler)}
+	}
+	if client.WAFConn != nil {
+		client.WAFConn = &waf.WAF{Client: withValidateHandler(client.WAFConn.Client, handler)}
+	}
+	if client.WAFRegionalConn != nil {
+		client.WAFRegionalConn = &wafregional.WAFRegional{Client: withValidateHandler(client.WAFRegionalConn.Client, handler)}
+	}
+	if client.WAFV2Conn != nil {
+		client.WAFV2Conn = &wafv2.WAFV2{Client: withValidateHandler(client.WAFV2Conn.Client, handler)}
+	}
+	if client.WellArchitectedConn != nil {
+		client.WellArchitectedConn = &wellarchitected.WellArchitected{Client: withValidateHandler(client.WellArchitectedConn.Client, handler)}
+	}
+	if client.WisdomConn != nil {
+		client.WisdomConn = &connectwisdomservice.ConnectWisdomService{Client: withValidateHandler(client.WisdomConn.Client, handler)}
+	}
+	if client.WorkDocsConn != nil {
+		client.WorkDocsConn = &workdocs.WorkDocs{Client: withValidateHandler(client.WorkDocsConn.Client, handler)}
+	}
+	if client.WorkLinkConn != nil {
+		client.WorkLinkConn = &worklink.WorkLink{Client: withValidateHandler(client.WorkLinkConn.Client, handler)}
+	}
+	if client.WorkMailConn != nil {
+		client.WorkMailConn = &workmail.WorkMail{Client: withValidateHandler(client.WorkMailConn.Client, handler)}
+	}
+	if client.WorkMailMessageFlowConn != nil {
+		client.WorkMailMessageFlowConn = &workmailmessageflow.WorkMailMessageFlow{Client: withValidateHandler(client.WorkMailMessageFlowConn.Client, handler)}
+	}
+	if client.WorkSpacesConn != nil {
+		client.WorkSpacesConn = &workspaces.WorkSpaces{Client: withValidateHandler(client.WorkSpacesConn.Client, handler)}
+	}
+	if client.WorkSpacesWebConn != nil {
+		client.WorkSpacesWebConn = &workspacesweb.WorkSpacesWeb{Client: withValidateHandler(client.WorkSpacesWebConn.Client, handler)}
+	}
+	if client.XRayConn != nil {
+		client.XRayConn = &xray.XRay{Client: withValidateHandler(client.XRayConn.Client, handler)}
+	}
+}
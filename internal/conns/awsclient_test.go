@@ -1,7 +1,13 @@
 package conns
 
 import (
+	"context"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:aws-in-func-name
@@ -77,3 +83,97 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:aws-in-func-name
 		})
 	}
 }
+
+func TestAWSClientForResourceType(t *testing.T) { // nosemgrep:aws-in-func-name
+	client := &AWSClient{
+		Region: "us-west-2", //lintignore:AWSAT003
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(map[string]string{"Environment": "test"}),
+			ResourceTypeTags: []*tftags.ResourceTypeDefaultConfig{
+				{
+					ResourceTypes: []string{"aws_ebs_*"},
+					Tags:          tftags.New(map[string]string{"Backup": "daily"}),
+				},
+			},
+		},
+	}
+
+	if got := client.ForResourceType("aws_instance").DefaultTagsConfig.GetTags().Map(); len(got) != 1 || got["Environment"] != "test" {
+		t.Errorf("unexpected default tags for aws_instance: %v", got)
+	}
+
+	got := client.ForResourceType("aws_ebs_volume")
+
+	if tags := got.DefaultTagsConfig.GetTags().Map(); len(tags) != 2 || tags["Backup"] != "daily" {
+		t.Errorf("unexpected default tags for aws_ebs_volume: %v", tags)
+	}
+
+	if got.Region != client.Region {
+		t.Errorf("got region %q, expected %q", got.Region, client.Region)
+	}
+
+	if len(client.DefaultTagsConfig.Tags) != 1 {
+		t.Errorf("original client default tags modified: %v", client.DefaultTagsConfig.Tags.Map())
+	}
+
+	noResourceTypeTags := &AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags: tftags.New(map[string]string{"Environment": "test"}),
+		},
+	}
+
+	if got := noResourceTypeTags.ForResourceType("aws_instance"); got != noResourceTypeTags {
+		t.Errorf("expected the same client when no resource type specific configuration is present")
+	}
+}
+
+func TestAWSClientForResourceTypeResourceTypeName(t *testing.T) { // nosemgrep:aws-in-func-name
+	client := &AWSClient{
+		EC2Conn:             ec2.New(session.Must(session.NewSession(&aws.Config{Region: aws.String("us-west-2")}))), //lintignore:AWSAT003
+		resourceTypeClients: newResourceTypeClients(),
+	}
+
+	got := client.ForResourceType("aws_instance")
+
+	if got != client.ForResourceType("aws_instance") {
+		t.Errorf("expected the same client for the same resource type")
+	}
+
+	testCases := []struct {
+		Name     string
+		Context  context.Context
+		Expected string
+	}{
+		{
+			Name:     "no context",
+			Expected: "aws_instance",
+		},
+		{
+			Name:     "resource type context",
+			Context:  NewResourceTypeNameContext(context.Background(), "aws_ebs_volume"),
+			Expected: "aws_ebs_volume",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			req, _ := got.EC2Conn.DescribeInstancesRequest(&ec2.DescribeInstancesInput{})
+			if testCase.Context != nil {
+				req.SetContext(testCase.Context)
+			}
+
+			req.Handlers.Validate.Run(req)
+
+			if typeName, _ := ResourceTypeNameFromContext(req.Context()); typeName != testCase.Expected {
+				t.Errorf("got resource type %q, expected %q", typeName, testCase.Expected)
+			}
+		})
+	}
+
+	req, _ := client.EC2Conn.DescribeInstancesRequest(&ec2.DescribeInstancesInput{})
+	req.Handlers.Validate.Run(req)
+
+	if typeName, ok := ResourceTypeNameFromContext(req.Context()); ok {
+		t.Errorf("original client set resource type %q", typeName)
+	}
+}
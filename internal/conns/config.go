@@ -3,39 +3,21 @@ package conns
 import (
 	"context"
 	"log"
-	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
-	"github.com/aws/aws-sdk-go-v2/service/kendra"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/aws/aws-sdk-go/service/appconfig"
-	"github.com/aws/aws-sdk-go/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go/service/appsync"
-	"github.com/aws/aws-sdk-go/service/chime"
-	"github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/aws/aws-sdk-go/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/configservice"
-	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/fms"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
-	"github.com/aws/aws-sdk-go/service/kafka"
-	"github.com/aws/aws-sdk-go/service/kinesis"
-	"github.com/aws/aws-sdk-go/service/lightsail"
-	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	"github.com/aws/aws-sdk-go/service/route53recoveryreadiness"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/aws/aws-sdk-go/service/shield"
-	"github.com/aws/aws-sdk-go/service/ssoadmin"
-	"github.com/aws/aws-sdk-go/service/storagegateway"
 	"github.com/aws/aws-sdk-go/service/sts"
-	"github.com/aws/aws-sdk-go/service/wafv2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -47,10 +29,12 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
+	APICallMetricsFile             string
+	AssumeRoles                    []*awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DefaultTimeoutsConfig          *DefaultTimeoutsConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -61,7 +45,10 @@ type Config struct {
 	Insecure                       bool
 	MaxRetries                     int
 	Profile                        string
+	RateLimits                     map[string]RateLimit
+	ReadOnly                       bool
 	Region                         string
+	RequiredTagsConfig             *tftags.RequiredConfig
 	S3UsePathStyle                 bool
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -76,6 +63,8 @@ type Config struct {
 	Token                          string
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
+
+	rateLimiters map[string]*rateLimiter
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -102,8 +91,10 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		UseFIPSEndpoint:               c.UseFIPSEndpoint,
 	}
 
-	if c.AssumeRole != nil && c.AssumeRole.RoleARN != "" {
-		awsbaseConfig.AssumeRole = c.AssumeRole
+	assumeRoles := c.assumeRoleChain()
+
+	if len(assumeRoles) > 0 {
+		awsbaseConfig.AssumeRole = assumeRoles[0]
 	}
 
 	if c.CustomCABundle != "" {
@@ -127,11 +118,30 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
-	cfg, err := awsbase.GetAwsConfig(ctx, &awsbaseConfig)
+	// Credentials validation by awsbase uses its own HTTP client, so when wrapping the transport it is
+	// deferred until the account ID and partition are retrieved using the wrapped client below.
+	transportWrapper, wrapTransport := httpTransportWrapperFromContext(ctx)
+	getAwsConfig := awsbaseConfig
+	if wrapTransport {
+		getAwsConfig.SkipCredsValidation = true
+	}
+
+	cfg, err := awsbase.GetAwsConfig(ctx, &getAwsConfig)
 	if err != nil {
 		return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
 	}
 
+	if wrapTransport {
+		cfg.HTTPClient = wrapHTTPDoer(cfg.HTTPClient, transportWrapper)
+	}
+
+	// The first role is assumed by awsbase, any further roles are assumed in order from the previous role's credentials.
+	for i := 1; i < len(assumeRoles); i++ {
+		if cfg.Credentials, err = c.assumeRoleCredentialsProvider(ctx, cfg, assumeRoles[i]); err != nil {
+			return nil, diag.Errorf("error configuring Terraform AWS Provider: %s", err)
+		}
+	}
+
 	if !c.SkipRegionValidation {
 		if err := awsbase.ValidateRegion(cfg.Region); err != nil {
 			return nil, diag.FromErr(err)
@@ -144,6 +154,10 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		return nil, diag.Errorf("error creating AWS SDK v1 session: %s", err)
 	}
 
+	if wrapTransport {
+		sess.Config.HTTPClient = wrapHTTPClient(sess.Config.HTTPClient, transportWrapper)
+	}
+
 	accountID, partition, err := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	if err != nil {
 		return nil, diag.Errorf("error retrieving account details: %s", err)
@@ -178,23 +192,50 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		DNSSuffix = p.DNSSuffix()
 	}
 
-	client := c.clientConns(sess)
+	if c.ReadOnly {
+		log.Printf("[INFO] Read-only mode enabled, mutating AWS API operations will be rejected")
+		sess.Handlers.Validate.PushFrontNamed(readOnlyHandler)
+		cfg.APIOptions = append(cfg.APIOptions, addReadOnlyMiddleware)
+	}
+
+	if c.APICallMetricsFile != "" {
+		recorder, err := apiCallMetricsRecorderForFile(c.APICallMetricsFile)
+		if err != nil {
+			return nil, diag.Errorf("error configuring API call metrics: %s", err)
+		}
+
+		log.Printf("[INFO] Recording AWS API call metrics to %s", c.APICallMetricsFile)
+		sess.Handlers.Retry.PushFrontNamed(recorder.retryHandler())
+		sess.Handlers.Complete.PushBackNamed(recorder.completeHandler())
+		cfg.APIOptions = append(cfg.APIOptions, recorder.addMiddleware)
+	}
+
+	c.rateLimiters = make(map[string]*rateLimiter, len(c.RateLimits))
+	for service, rateLimit := range c.RateLimits {
+		log.Printf("[INFO] Limiting %s requests to %g per second (burst %d)", service, rateLimit.RequestsPerSecond, rateLimit.Burst)
+		c.rateLimiters[service] = newRateLimiter(rateLimit)
+	}
+
+	client := c.clientConns(sess, cfg)
 
 	client.AccountID = accountID
+	for _, assumeRole := range assumeRoles {
+		client.AssumedRoleARNs = append(client.AssumedRoleARNs, assumeRole.RoleARN)
+	}
 	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.DefaultTimeoutsConfig = c.DefaultTimeoutsConfig
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.RequiredTagsConfig = c.RequiredTagsConfig
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
-	client.KendraConn = kendra.NewFromConfig(cfg, func(o *kendra.Options) {
-		if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
-			o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
-		}
-	})
+	if c.APICallMetricsFile != "" {
+		client.resourceTypeClients = newResourceTypeClients()
+	}
 
 	client.Route53DomainsConn = route53domains.NewFromConfig(cfg, func(o *route53domains.Options) {
 		if endpoint := c.Endpoints[names.Route53Domains]; endpoint != "" {
@@ -203,6 +244,9 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			// Route 53 Domains is only available in AWS Commercial us-east-1 Region.
 			o.Region = endpoints.UsEast1RegionID
 		}
+		if l, ok := c.rateLimiters[names.Route53Domains]; ok {
+			o.APIOptions = append(o.APIOptions, l.addMiddleware)
+		}
 	})
 
 	// sts
@@ -214,7 +258,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		stsConfig.Region = aws.String(c.STSRegion)
 	}
 
-	client.STSConn = sts.New(sess.Copy(stsConfig))
+	client.STSConn = sts.New(c.sessionForService(sess, names.STS, stsConfig))
 
 	// "Global" services that require customizations
 	globalAcceleratorConfig := &aws.Config{
@@ -239,10 +283,10 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		S3ForcePathStyle: aws.Bool(c.S3UsePathStyle),
 	}
 
-	client.S3Conn = s3.New(sess.Copy(s3Config))
+	client.S3Conn = s3.New(c.sessionForService(sess, names.S3, s3Config))
 
 	s3Config.DisableRestProtocolURICleaning = aws.Bool(true)
-	client.S3ConnURICleaningDisabled = s3.New(sess.Copy(s3Config))
+	client.S3ConnURICleaningDisabled = s3.New(c.sessionForService(sess, names.S3, s3Config))
 
 	// Force "global" services to correct regions
 	switch partition {
@@ -263,66 +307,15 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		route53Config.Region = aws.String(endpoints.UsGovWest1RegionID)
 	}
 
-	client.GlobalAcceleratorConn = globalaccelerator.New(sess.Copy(globalAcceleratorConfig))
-	client.Route53Conn = route53.New(sess.Copy(route53Config))
-	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(sess.Copy(route53RecoveryControlConfigConfig))
-	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(sess.Copy(route53RecoveryReadinessConfig))
-	client.ShieldConn = shield.New(sess.Copy(shieldConfig))
+	client.GlobalAcceleratorConn = globalaccelerator.New(c.sessionForService(sess, names.GlobalAccelerator, globalAcceleratorConfig))
+	client.Route53Conn = route53.New(c.sessionForService(sess, names.Route53, route53Config))
+	client.Route53RecoveryControlConfigConn = route53recoverycontrolconfig.New(c.sessionForService(sess, names.Route53RecoveryControlConfig, route53RecoveryControlConfigConfig))
+	client.Route53RecoveryReadinessConn = route53recoveryreadiness.New(c.sessionForService(sess, names.Route53RecoveryReadiness, route53RecoveryReadinessConfig))
+	client.ShieldConn = shield.New(c.sessionForService(sess, names.Shield, shieldConfig))
 
-	client.APIGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Many operations can return an error such as:
-		//   ConflictException: Unable to complete operation due to concurrent modification. Please try again later.
-		// Handle them all globally for the service client.
-		if tfawserr.ErrMessageContains(r.Error, apigateway.ErrCodeConflictException, "try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Workaround for https://github.com/aws/aws-sdk-go/issues/1472
-	client.AppAutoScalingConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if !strings.HasPrefix(r.Operation.Name, "Describe") && !strings.HasPrefix(r.Operation.Name, "List") {
-			return
-		}
-		if tfawserr.ErrCodeEquals(r.Error, applicationautoscaling.ErrCodeFailedResourceAccessException) {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// StartDeployment operations can return a ConflictException
-	// if ongoing deployments are in-progress, thus we handle them
-	// here for the service client.
-	client.AppConfigConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "StartDeployment" {
-			if tfawserr.ErrCodeEquals(r.Error, appconfig.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.AppSyncConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateGraphqlApi" {
-			if tfawserr.ErrMessageContains(r.Error, appsync.ErrCodeConcurrentModificationException, "a GraphQL API creation is already in progress") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.ChimeConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// When calling CreateVoiceConnector across multiple resources,
-		// the API can randomly return a BadRequestException without explanation
-		if r.Operation.Name == "CreateVoiceConnector" {
-			if tfawserr.ErrMessageContains(r.Error, chime.ErrCodeBadRequestException, "Service received a bad request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.CloudHSMV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudhsmv2.ErrCodeCloudHsmInternalFailureException, "request was rejected because of an AWS CloudHSM internal failure") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	c.addRetryableErrorsHandlers(client)
 
+	// Retry handlers more complex than the RetryableErrors in names_data.csv.
 	client.ConfigServiceConn.Handlers.Retry.PushBack(func(r *request.Request) {
 		// When calling Config Organization Rules API actions immediately
 		// after Organization creation, the API can randomly return the
@@ -345,9 +338,6 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 			}
 		case "DeleteOrganizationConformancePack", "DescribeOrganizationConformancePacks", "DescribeOrganizationConformancePackStatuses", "PutOrganizationConformancePack":
 			if !tfawserr.ErrCodeEquals(r.Error, configservice.ErrCodeOrganizationAccessDeniedException) {
-				if r.Operation.Name == "DeleteOrganizationConformancePack" && tfawserr.ErrCodeEquals(err, configservice.ErrCodeResourceInUseException) {
-					r.Retryable = aws.Bool(true)
-				}
 				return
 			}
 
@@ -363,169 +353,6 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 		}
 	})
 
-	client.CloudFormationConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, cloudformation.ErrCodeOperationInProgressException, "Another Operation on StackSet") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.DynamoDBConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if tfawserr.ErrMessageContains(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.EC2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch err := r.Error; r.Operation.Name {
-		case "AttachVpnGateway", "DetachVpnGateway":
-			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "This call cannot be completed because there are pending VPNs or Virtual Interfaces") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateClientVpnEndpoint":
-			if tfawserr.ErrMessageContains(err, "OperationNotPermitted", "Endpoint cannot be created while another endpoint is being created") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateClientVpnRoute", "DeleteClientVpnRoute":
-			if tfawserr.ErrMessageContains(err, "ConcurrentMutationLimitExceeded", "Cannot initiate another change for this endpoint at this time") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateVpnConnection":
-			if tfawserr.ErrMessageContains(err, "VpnConnectionLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-
-		case "CreateVpnGateway":
-			if tfawserr.ErrMessageContains(err, "VpnGatewayLimitExceeded", "maximum number of mutating objects has been reached") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.FMSConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Acceptance testing creates and deletes resources in quick succession.
-		// The FMS onboarding process into Organizations is opaque to consumers.
-		// Since we cannot reasonably check this status before receiving the error,
-		// set the operation as retryable.
-		switch r.Operation.Name {
-		case "AssociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DisassociateAdminAccount":
-			if tfawserr.ErrMessageContains(r.Error, fms.ErrCodeInvalidOperationException, "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded.") {
-				r.Retryable = aws.Bool(true)
-			}
-		// System problems can arise during FMS policy updates (maybe also creation),
-		// so we set the following operation as retryable.
-		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/23946
-		case "PutPolicy":
-			if tfawserr.ErrCodeEquals(r.Error, fms.ErrCodeInternalErrorException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.KafkaConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, kafka.ErrCodeTooManyRequestsException, "Too Many Requests") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.KinesisConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "CreateStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "simultaneously be in CREATING or DELETING") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-		if r.Operation.Name == "CreateStream" || r.Operation.Name == "DeleteStream" {
-			if tfawserr.ErrMessageContains(r.Error, kinesis.ErrCodeLimitExceededException, "Rate exceeded for stream") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.LightsailConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "CreateContainerService", "UpdateContainerService", "CreateContainerServiceDeployment":
-			if tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please try again in a few minutes") {
-				r.Retryable = aws.Bool(true)
-			}
-		case "DeleteContainerService":
-			if tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please try again in a few minutes") ||
-				tfawserr.ErrMessageContains(r.Error, lightsail.ErrCodeInvalidInputException, "Please wait for it to complete before trying again") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.OrganizationsConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// Retry on the following error:
-		// ConcurrentModificationException: AWS Organizations can't complete your request because it conflicts with another attempt to modify the same entity. Try again later.
-		if tfawserr.ErrMessageContains(r.Error, organizations.ErrCodeConcurrentModificationException, "Try again later") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.S3Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, "OperationAborted", "A conflicting conditional operation is currently in progress against this resource. Please try again.") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/17996
-	client.SecurityHubConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		switch r.Operation.Name {
-		case "EnableOrganizationAdminAccount":
-			if tfawserr.ErrCodeEquals(r.Error, securityhub.ErrCodeResourceConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/19215
-	client.SSOAdminConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name == "AttachManagedPolicyToPermissionSet" || r.Operation.Name == "DetachManagedPolicyFromPermissionSet" {
-			if tfawserr.ErrCodeEquals(r.Error, ssoadmin.ErrCodeConflictException) {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
-	client.StorageGatewayConn.Handlers.Retry.PushBack(func(r *request.Request) {
-		// InvalidGatewayRequestException: The specified gateway proxy network connection is busy.
-		if tfawserr.ErrMessageContains(r.Error, storagegateway.ErrCodeInvalidGatewayRequestException, "The specified gateway proxy network connection is busy") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
-
-	client.WAFV2Conn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFInternalErrorException, "Retry your request") {
-			r.Retryable = aws.Bool(true)
-		}
-
-		if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFServiceLinkedRoleErrorException, "Retry") {
-			r.Retryable = aws.Bool(true)
-		}
-
-		if r.Operation.Name == "CreateIPSet" || r.Operation.Name == "CreateRegexPatternSet" ||
-			r.Operation.Name == "CreateRuleGroup" || r.Operation.Name == "CreateWebACL" {
-			// WAFv2 supports tag on create which can result in the below error codes according to the documentation
-			if tfawserr.ErrMessageContains(r.Error, wafv2.ErrCodeWAFTagOperationException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-			if tfawserr.ErrMessageContains(err, wafv2.ErrCodeWAFTagOperationInternalErrorException, "Retry your request") {
-				r.Retryable = aws.Bool(true)
-			}
-		}
-	})
-
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.EC2Conn)
 		if err != nil {
@@ -539,3 +366,16 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 
 	return client, nil
 }
+
+// sessionForService returns a copy of the session for the specified service with the configured
+// endpoint and any client-side rate limit applied. Additional configurations are merged in order.
+func (c *Config) sessionForService(sess *session.Session, service string, cfgs ...*aws.Config) *session.Session {
+	cfgs = append([]*aws.Config{{Endpoint: aws.String(c.Endpoints[service])}}, cfgs...)
+	sess = sess.Copy(cfgs...)
+
+	if l, ok := c.rateLimiters[service]; ok {
+		sess.Handlers.Sign.PushFrontNamed(l.handler())
+	}
+
+	return sess
+}
@@ -2,7 +2,8 @@
 package conns
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -298,298 +299,381 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func (c *Config) clientConns(sess *session.Session) *AWSClient {
+func (c *Config) clientConns(sess *session.Session, cfg aws.Config) *AWSClient {
 	return &AWSClient{
-		ACMConn:                          acm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACM])})),
-		ACMPCAConn:                       acmpca.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ACMPCA])})),
-		AMPConn:                          prometheusservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AMP])})),
-		APIGatewayConn:                   apigateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGateway])})),
-		APIGatewayManagementAPIConn:      apigatewaymanagementapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayManagementAPI])})),
-		APIGatewayV2Conn:                 apigatewayv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.APIGatewayV2])})),
-		AccessAnalyzerConn:               accessanalyzer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AccessAnalyzer])})),
-		AccountConn:                      account.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Account])})),
-		AlexaForBusinessConn:             alexaforbusiness.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AlexaForBusiness])})),
-		AmplifyConn:                      amplify.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Amplify])})),
-		AmplifyBackendConn:               amplifybackend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyBackend])})),
-		AmplifyUIBuilderConn:             amplifyuibuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AmplifyUIBuilder])})),
-		AppAutoScalingConn:               applicationautoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppAutoScaling])})),
-		AppConfigConn:                    appconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfig])})),
-		AppConfigDataConn:                appconfigdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppConfigData])})),
-		AppFlowConn:                      appflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppFlow])})),
-		AppIntegrationsConn:              appintegrationsservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppIntegrations])})),
-		AppMeshConn:                      appmesh.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppMesh])})),
-		AppRunnerConn:                    apprunner.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppRunner])})),
-		AppStreamConn:                    appstream.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppStream])})),
-		AppSyncConn:                      appsync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AppSync])})),
-		ApplicationCostProfilerConn:      applicationcostprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationCostProfiler])})),
-		ApplicationInsightsConn:          applicationinsights.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ApplicationInsights])})),
-		AthenaConn:                       athena.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Athena])})),
-		AuditManagerConn:                 auditmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AuditManager])})),
-		AutoScalingConn:                  autoscaling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScaling])})),
-		AutoScalingPlansConn:             autoscalingplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.AutoScalingPlans])})),
-		BackupConn:                       backup.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Backup])})),
-		BackupGatewayConn:                backupgateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BackupGateway])})),
-		BatchConn:                        batch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Batch])})),
-		BillingConductorConn:             billingconductor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.BillingConductor])})),
-		BraketConn:                       braket.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Braket])})),
-		BudgetsConn:                      budgets.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Budgets])})),
-		CEConn:                           costexplorer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CE])})),
-		CURConn:                          costandusagereportservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CUR])})),
-		ChimeConn:                        chime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Chime])})),
-		ChimeSDKIdentityConn:             chimesdkidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKIdentity])})),
-		ChimeSDKMeetingsConn:             chimesdkmeetings.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMeetings])})),
-		ChimeSDKMessagingConn:            chimesdkmessaging.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ChimeSDKMessaging])})),
-		Cloud9Conn:                       cloud9.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Cloud9])})),
-		CloudControlConn:                 cloudcontrolapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudControl])})),
-		CloudDirectoryConn:               clouddirectory.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudDirectory])})),
-		CloudFormationConn:               cloudformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFormation])})),
-		CloudFrontConn:                   cloudfront.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudFront])})),
-		CloudHSMV2Conn:                   cloudhsmv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudHSMV2])})),
-		CloudSearchConn:                  cloudsearch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearch])})),
-		CloudSearchDomainConn:            cloudsearchdomain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudSearchDomain])})),
-		CloudTrailConn:                   cloudtrail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudTrail])})),
-		CloudWatchConn:                   cloudwatch.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CloudWatch])})),
-		CodeArtifactConn:                 codeartifact.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeArtifact])})),
-		CodeBuildConn:                    codebuild.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeBuild])})),
-		CodeCommitConn:                   codecommit.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeCommit])})),
-		CodeGuruProfilerConn:             codeguruprofiler.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruProfiler])})),
-		CodeGuruReviewerConn:             codegurureviewer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeGuruReviewer])})),
-		CodePipelineConn:                 codepipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodePipeline])})),
-		CodeStarConn:                     codestar.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStar])})),
-		CodeStarConnectionsConn:          codestarconnections.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarConnections])})),
-		CodeStarNotificationsConn:        codestarnotifications.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CodeStarNotifications])})),
-		CognitoIDPConn:                   cognitoidentityprovider.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIDP])})),
-		CognitoIdentityConn:              cognitoidentity.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoIdentity])})),
-		CognitoSyncConn:                  cognitosync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CognitoSync])})),
-		ComprehendConn:                   comprehend.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Comprehend])})),
-		ComprehendMedicalConn:            comprehendmedical.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComprehendMedical])})),
-		ComputeOptimizerConn:             computeoptimizer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ComputeOptimizer])})),
-		ConfigServiceConn:                configservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConfigService])})),
-		ConnectConn:                      connect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Connect])})),
-		ConnectContactLensConn:           connectcontactlens.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectContactLens])})),
-		ConnectParticipantConn:           connectparticipant.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ConnectParticipant])})),
-		CustomerProfilesConn:             customerprofiles.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.CustomerProfiles])})),
-		DAXConn:                          dax.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DAX])})),
-		DLMConn:                          dlm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DLM])})),
-		DMSConn:                          databasemigrationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DMS])})),
-		DRSConn:                          drs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DRS])})),
-		DSConn:                           directoryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DS])})),
-		DataBrewConn:                     gluedatabrew.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataBrew])})),
-		DataExchangeConn:                 dataexchange.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataExchange])})),
-		DataPipelineConn:                 datapipeline.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataPipeline])})),
-		DataSyncConn:                     datasync.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DataSync])})),
-		DeployConn:                       codedeploy.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Deploy])})),
-		DetectiveConn:                    detective.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Detective])})),
-		DevOpsGuruConn:                   devopsguru.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DevOpsGuru])})),
-		DeviceFarmConn:                   devicefarm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DeviceFarm])})),
-		DirectConnectConn:                directconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DirectConnect])})),
-		DiscoveryConn:                    applicationdiscoveryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Discovery])})),
-		DocDBConn:                        docdb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DocDB])})),
-		DynamoDBConn:                     dynamodb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDB])})),
-		DynamoDBStreamsConn:              dynamodbstreams.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.DynamoDBStreams])})),
-		EBSConn:                          ebs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EBS])})),
-		EC2Conn:                          ec2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2])})),
-		EC2InstanceConnectConn:           ec2instanceconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EC2InstanceConnect])})),
-		ECRConn:                          ecr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECR])})),
-		ECRPublicConn:                    ecrpublic.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECRPublic])})),
-		ECSConn:                          ecs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ECS])})),
-		EFSConn:                          efs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EFS])})),
-		EKSConn:                          eks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EKS])})),
-		ELBConn:                          elb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELB])})),
-		ELBV2Conn:                        elbv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ELBV2])})),
-		EMRConn:                          emr.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMR])})),
-		EMRContainersConn:                emrcontainers.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRContainers])})),
-		EMRServerlessConn:                emrserverless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.EMRServerless])})),
-		ElastiCacheConn:                  elasticache.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElastiCache])})),
-		ElasticBeanstalkConn:             elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticBeanstalk])})),
-		ElasticInferenceConn:             elasticinference.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticInference])})),
-		ElasticTranscoderConn:            elastictranscoder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ElasticTranscoder])})),
-		ElasticsearchConn:                elasticsearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Elasticsearch])})),
-		EventsConn:                       eventbridge.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Events])})),
-		EvidentlyConn:                    cloudwatchevidently.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Evidently])})),
-		FISConn:                          fis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FIS])})),
-		FMSConn:                          fms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FMS])})),
-		FSxConn:                          fsx.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FSx])})),
-		FinSpaceConn:                     finspace.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpace])})),
-		FinSpaceDataConn:                 finspacedata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FinSpaceData])})),
-		FirehoseConn:                     firehose.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Firehose])})),
-		ForecastConn:                     forecastservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Forecast])})),
-		ForecastQueryConn:                forecastqueryservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ForecastQuery])})),
-		FraudDetectorConn:                frauddetector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.FraudDetector])})),
-		GameLiftConn:                     gamelift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GameLift])})),
-		GlacierConn:                      glacier.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glacier])})),
-		GlueConn:                         glue.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Glue])})),
-		GrafanaConn:                      managedgrafana.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Grafana])})),
-		GreengrassConn:                   greengrass.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Greengrass])})),
-		GreengrassV2Conn:                 greengrassv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GreengrassV2])})),
-		GroundStationConn:                groundstation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GroundStation])})),
-		GuardDutyConn:                    guardduty.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.GuardDuty])})),
-		HealthConn:                       health.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Health])})),
-		HealthLakeConn:                   healthlake.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.HealthLake])})),
-		HoneycodeConn:                    honeycode.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Honeycode])})),
-		IAMConn:                          iam.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IAM])})),
-		IVSConn:                          ivs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IVS])})),
-		IdentityStoreConn:                identitystore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IdentityStore])})),
-		ImageBuilderConn:                 imagebuilder.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ImageBuilder])})),
-		InspectorConn:                    inspector.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector])})),
-		Inspector2Conn:                   inspector2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Inspector2])})),
-		IoTConn:                          iot.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT])})),
-		IoT1ClickDevicesConn:             iot1clickdevicesservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickDevices])})),
-		IoT1ClickProjectsConn:            iot1clickprojects.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoT1ClickProjects])})),
-		IoTAnalyticsConn:                 iotanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTAnalytics])})),
-		IoTDataConn:                      iotdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTData])})),
-		IoTDeviceAdvisorConn:             iotdeviceadvisor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTDeviceAdvisor])})),
-		IoTEventsConn:                    iotevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEvents])})),
-		IoTEventsDataConn:                ioteventsdata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTEventsData])})),
-		IoTFleetHubConn:                  iotfleethub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTFleetHub])})),
-		IoTJobsDataConn:                  iotjobsdataplane.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTJobsData])})),
-		IoTSecureTunnelingConn:           iotsecuretunneling.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSecureTunneling])})),
-		IoTSiteWiseConn:                  iotsitewise.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTSiteWise])})),
-		IoTThingsGraphConn:               iotthingsgraph.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTThingsGraph])})),
-		IoTTwinMakerConn:                 iottwinmaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTTwinMaker])})),
-		IoTWirelessConn:                  iotwireless.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.IoTWireless])})),
-		KMSConn:                          kms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KMS])})),
-		KafkaConn:                        kafka.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kafka])})),
-		KafkaConnectConn:                 kafkaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KafkaConnect])})),
-		KeyspacesConn:                    keyspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Keyspaces])})),
-		KinesisConn:                      kinesis.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Kinesis])})),
-		KinesisAnalyticsConn:             kinesisanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalytics])})),
-		KinesisAnalyticsV2Conn:           kinesisanalyticsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisAnalyticsV2])})),
-		KinesisVideoConn:                 kinesisvideo.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideo])})),
-		KinesisVideoArchivedMediaConn:    kinesisvideoarchivedmedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoArchivedMedia])})),
-		KinesisVideoMediaConn:            kinesisvideomedia.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoMedia])})),
-		KinesisVideoSignalingConn:        kinesisvideosignalingchannels.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.KinesisVideoSignaling])})),
-		LakeFormationConn:                lakeformation.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LakeFormation])})),
-		LambdaConn:                       lambda.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lambda])})),
-		LexModelsConn:                    lexmodelbuildingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModels])})),
-		LexModelsV2Conn:                  lexmodelsv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexModelsV2])})),
-		LexRuntimeConn:                   lexruntimeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntime])})),
-		LexRuntimeV2Conn:                 lexruntimev2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LexRuntimeV2])})),
-		LicenseManagerConn:               licensemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LicenseManager])})),
-		LightsailConn:                    lightsail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Lightsail])})),
-		LocationConn:                     locationservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Location])})),
-		LogsConn:                         cloudwatchlogs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Logs])})),
-		LookoutEquipmentConn:             lookoutequipment.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutEquipment])})),
-		LookoutMetricsConn:               lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutMetrics])})),
-		LookoutVisionConn:                lookoutforvision.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.LookoutVision])})),
-		MQConn:                           mq.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MQ])})),
-		MTurkConn:                        mturk.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MTurk])})),
-		MWAAConn:                         mwaa.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MWAA])})),
-		MachineLearningConn:              machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MachineLearning])})),
-		MacieConn:                        macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie])})),
-		Macie2Conn:                       macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Macie2])})),
-		ManagedBlockchainConn:            managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ManagedBlockchain])})),
-		MarketplaceCatalogConn:           marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCatalog])})),
-		MarketplaceCommerceAnalyticsConn: marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceCommerceAnalytics])})),
-		MarketplaceEntitlementConn:       marketplaceentitlementservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceEntitlement])})),
-		MarketplaceMeteringConn:          marketplacemetering.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MarketplaceMetering])})),
-		MediaConnectConn:                 mediaconnect.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConnect])})),
-		MediaConvertConn:                 mediaconvert.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaConvert])})),
-		MediaLiveConn:                    medialive.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaLive])})),
-		MediaPackageConn:                 mediapackage.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackage])})),
-		MediaPackageVODConn:              mediapackagevod.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaPackageVOD])})),
-		MediaStoreConn:                   mediastore.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStore])})),
-		MediaStoreDataConn:               mediastoredata.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaStoreData])})),
-		MediaTailorConn:                  mediatailor.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MediaTailor])})),
-		MemoryDBConn:                     memorydb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MemoryDB])})),
-		MgHConn:                          migrationhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MgH])})),
-		MgnConn:                          mgn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mgn])})),
-		MigrationHubConfigConn:           migrationhubconfig.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubConfig])})),
-		MigrationHubRefactorSpacesConn:   migrationhubrefactorspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubRefactorSpaces])})),
-		MigrationHubStrategyConn:         migrationhubstrategyrecommendations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.MigrationHubStrategy])})),
-		MobileConn:                       mobile.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Mobile])})),
-		NeptuneConn:                      neptune.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Neptune])})),
-		NetworkFirewallConn:              networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkFirewall])})),
-		NetworkManagerConn:               networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.NetworkManager])})),
-		NimbleConn:                       nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Nimble])})),
-		OpenSearchConn:                   opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpenSearch])})),
-		OpsWorksConn:                     opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorks])})),
-		OpsWorksCMConn:                   opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.OpsWorksCM])})),
-		OrganizationsConn:                organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Organizations])})),
-		OutpostsConn:                     outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Outposts])})),
-		PIConn:                           pi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PI])})),
-		PanoramaConn:                     panorama.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Panorama])})),
-		PersonalizeConn:                  personalize.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Personalize])})),
-		PersonalizeEventsConn:            personalizeevents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeEvents])})),
-		PersonalizeRuntimeConn:           personalizeruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PersonalizeRuntime])})),
-		PinpointConn:                     pinpoint.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pinpoint])})),
-		PinpointEmailConn:                pinpointemail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointEmail])})),
-		PinpointSMSVoiceConn:             pinpointsmsvoice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.PinpointSMSVoice])})),
-		PollyConn:                        polly.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Polly])})),
-		PricingConn:                      pricing.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Pricing])})),
-		ProtonConn:                       proton.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Proton])})),
-		QLDBConn:                         qldb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDB])})),
-		QLDBSessionConn:                  qldbsession.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QLDBSession])})),
-		QuickSightConn:                   quicksight.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.QuickSight])})),
-		RAMConn:                          ram.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RAM])})),
-		RBinConn:                         recyclebin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RBin])})),
-		RDSConn:                          rds.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDS])})),
-		RDSDataConn:                      rdsdataservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RDSData])})),
-		RUMConn:                          cloudwatchrum.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RUM])})),
-		RedshiftConn:                     redshift.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Redshift])})),
-		RedshiftDataConn:                 redshiftdataapiservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RedshiftData])})),
-		RekognitionConn:                  rekognition.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Rekognition])})),
-		ResilienceHubConn:                resiliencehub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResilienceHub])})),
-		ResourceGroupsConn:               resourcegroups.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroups])})),
-		ResourceGroupsTaggingAPIConn:     resourcegroupstaggingapi.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ResourceGroupsTaggingAPI])})),
-		RoboMakerConn:                    robomaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.RoboMaker])})),
-		Route53RecoveryClusterConn:       route53recoverycluster.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53RecoveryCluster])})),
-		Route53ResolverConn:              route53resolver.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Route53Resolver])})),
-		S3ControlConn:                    s3control.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Control])})),
-		S3OutpostsConn:                   s3outposts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.S3Outposts])})),
-		SESConn:                          ses.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SES])})),
-		SESV2Conn:                        sesv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SESV2])})),
-		SFNConn:                          sfn.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SFN])})),
-		SMSConn:                          sms.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SMS])})),
-		SNSConn:                          sns.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SNS])})),
-		SQSConn:                          sqs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SQS])})),
-		SSMConn:                          ssm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSM])})),
-		SSMContactsConn:                  ssmcontacts.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMContacts])})),
-		SSMIncidentsConn:                 ssmincidents.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSMIncidents])})),
-		SSOConn:                          sso.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSO])})),
-		SSOAdminConn:                     ssoadmin.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOAdmin])})),
-		SSOOIDCConn:                      ssooidc.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SSOOIDC])})),
-		SWFConn:                          swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SWF])})),
-		SageMakerConn:                    sagemaker.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMaker])})),
-		SageMakerA2IRuntimeConn:          augmentedairuntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerA2IRuntime])})),
-		SageMakerEdgeConn:                sagemakeredgemanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerEdge])})),
-		SageMakerFeatureStoreRuntimeConn: sagemakerfeaturestoreruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerFeatureStoreRuntime])})),
-		SageMakerRuntimeConn:             sagemakerruntime.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SageMakerRuntime])})),
-		SavingsPlansConn:                 savingsplans.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SavingsPlans])})),
-		SchemasConn:                      schemas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Schemas])})),
-		SecretsManagerConn:               secretsmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecretsManager])})),
-		SecurityHubConn:                  securityhub.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SecurityHub])})),
-		ServerlessRepoConn:               serverlessapplicationrepository.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServerlessRepo])})),
-		ServiceCatalogConn:               servicecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalog])})),
-		ServiceCatalogAppRegistryConn:    appregistry.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceCatalogAppRegistry])})),
-		ServiceDiscoveryConn:             servicediscovery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceDiscovery])})),
-		ServiceQuotasConn:                servicequotas.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.ServiceQuotas])})),
-		SignerConn:                       signer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Signer])})),
-		SimpleDBConn:                     simpledb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SimpleDB])})),
-		SnowDeviceManagementConn:         snowdevicemanagement.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.SnowDeviceManagement])})),
-		SnowballConn:                     snowball.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Snowball])})),
-		StorageGatewayConn:               storagegateway.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.StorageGateway])})),
-		SupportConn:                      support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Support])})),
-		SyntheticsConn:                   synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Synthetics])})),
-		TextractConn:                     textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Textract])})),
-		TimestreamQueryConn:              timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamQuery])})),
-		TimestreamWriteConn:              timestreamwrite.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TimestreamWrite])})),
-		TranscribeConn:                   transcribeservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transcribe])})),
-		TranscribeStreamingConn:          transcribestreamingservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.TranscribeStreaming])})),
-		TransferConn:                     transfer.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Transfer])})),
-		TranslateConn:                    translate.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Translate])})),
-		VoiceIDConn:                      voiceid.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.VoiceID])})),
-		WAFConn:                          waf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAF])})),
-		WAFRegionalConn:                  wafregional.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFRegional])})),
-		WAFV2Conn:                        wafv2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WAFV2])})),
-		WellArchitectedConn:              wellarchitected.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WellArchitected])})),
-		WisdomConn:                       connectwisdomservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.Wisdom])})),
-		WorkDocsConn:                     workdocs.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkDocs])})),
-		WorkLinkConn:                     worklink.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkLink])})),
-		WorkMailConn:                     workmail.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMail])})),
-		WorkMailMessageFlowConn:          workmailmessageflow.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkMailMessageFlow])})),
-		WorkSpacesConn:                   workspaces.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpaces])})),
-		WorkSpacesWebConn:                workspacesweb.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.WorkSpacesWeb])})),
-		XRayConn:                         xray.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.XRay])})),
+		ACMConn:                     acm.New(c.sessionForService(sess, names.ACM)),
+		ACMPCAConn:                  acmpca.New(c.sessionForService(sess, names.ACMPCA)),
+		AMPConn:                     prometheusservice.New(c.sessionForService(sess, names.AMP)),
+		APIGatewayConn:              apigateway.New(c.sessionForService(sess, names.APIGateway)),
+		APIGatewayManagementAPIConn: apigatewaymanagementapi.New(c.sessionForService(sess, names.APIGatewayManagementAPI)),
+		APIGatewayV2Conn:            apigatewayv2.New(c.sessionForService(sess, names.APIGatewayV2)),
+		AccessAnalyzerConn:          accessanalyzer.New(c.sessionForService(sess, names.AccessAnalyzer)),
+		AccountConn:                 account.New(c.sessionForService(sess, names.Account)),
+		AlexaForBusinessConn:        alexaforbusiness.New(c.sessionForService(sess, names.AlexaForBusiness)),
+		AmplifyConn:                 amplify.New(c.sessionForService(sess, names.Amplify)),
+		AmplifyBackendConn:          amplifybackend.New(c.sessionForService(sess, names.AmplifyBackend)),
+		AmplifyUIBuilderConn:        amplifyuibuilder.New(c.sessionForService(sess, names.AmplifyUIBuilder)),
+		AppAutoScalingConn:          applicationautoscaling.New(c.sessionForService(sess, names.AppAutoScaling)),
+		AppConfigConn:               appconfig.New(c.sessionForService(sess, names.AppConfig)),
+		AppConfigDataConn:           appconfigdata.New(c.sessionForService(sess, names.AppConfigData)),
+		AppFlowConn:                 appflow.New(c.sessionForService(sess, names.AppFlow)),
+		AppIntegrationsConn:         appintegrationsservice.New(c.sessionForService(sess, names.AppIntegrations)),
+		AppMeshConn:                 appmesh.New(c.sessionForService(sess, names.AppMesh)),
+		AppRunnerConn:               apprunner.New(c.sessionForService(sess, names.AppRunner)),
+		AppStreamConn:               appstream.New(c.sessionForService(sess, names.AppStream)),
+		AppSyncConn:                 appsync.New(c.sessionForService(sess, names.AppSync)),
+		ApplicationCostProfilerConn: applicationcostprofiler.New(c.sessionForService(sess, names.ApplicationCostProfiler)),
+		ApplicationInsightsConn:     applicationinsights.New(c.sessionForService(sess, names.ApplicationInsights)),
+		AthenaConn:                  athena.New(c.sessionForService(sess, names.Athena)),
+		AuditManagerConn:            auditmanager.New(c.sessionForService(sess, names.AuditManager)),
+		AutoScalingConn:             autoscaling.New(c.sessionForService(sess, names.AutoScaling)),
+		AutoScalingPlansConn:        autoscalingplans.New(c.sessionForService(sess, names.AutoScalingPlans)),
+		BackupConn:                  backup.New(c.sessionForService(sess, names.Backup)),
+		BackupGatewayConn:           backupgateway.New(c.sessionForService(sess, names.BackupGateway)),
+		BatchConn:                   batch.New(c.sessionForService(sess, names.Batch)),
+		BillingConductorConn:        billingconductor.New(c.sessionForService(sess, names.BillingConductor)),
+		BraketConn:                  braket.New(c.sessionForService(sess, names.Braket)),
+		BudgetsConn:                 budgets.New(c.sessionForService(sess, names.Budgets)),
+		CEConn:                      costexplorer.New(c.sessionForService(sess, names.CE)),
+		CURConn:                     costandusagereportservice.New(c.sessionForService(sess, names.CUR)),
+		ChimeConn:                   chime.New(c.sessionForService(sess, names.Chime)),
+		ChimeSDKIdentityConn:        chimesdkidentity.New(c.sessionForService(sess, names.ChimeSDKIdentity)),
+		ChimeSDKMeetingsConn:        chimesdkmeetings.New(c.sessionForService(sess, names.ChimeSDKMeetings)),
+		ChimeSDKMessagingConn:       chimesdkmessaging.New(c.sessionForService(sess, names.ChimeSDKMessaging)),
+		Cloud9Conn:                  cloud9.New(c.sessionForService(sess, names.Cloud9)),
+		CloudControlConn:            cloudcontrolapi.New(c.sessionForService(sess, names.CloudControl)),
+		CloudDirectoryConn:          clouddirectory.New(c.sessionForService(sess, names.CloudDirectory)),
+		CloudFormationConn:          cloudformation.New(c.sessionForService(sess, names.CloudFormation)),
+		CloudFrontConn:              cloudfront.New(c.sessionForService(sess, names.CloudFront)),
+		CloudHSMV2Conn:              cloudhsmv2.New(c.sessionForService(sess, names.CloudHSMV2)),
+		CloudSearchConn:             cloudsearch.New(c.sessionForService(sess, names.CloudSearch)),
+		CloudSearchDomainConn:       cloudsearchdomain.New(c.sessionForService(sess, names.CloudSearchDomain)),
+		CloudTrailConn:              cloudtrail.New(c.sessionForService(sess, names.CloudTrail)),
+		CloudWatchConn:              cloudwatch.New(c.sessionForService(sess, names.CloudWatch)),
+		CodeArtifactConn:            codeartifact.New(c.sessionForService(sess, names.CodeArtifact)),
+		CodeBuildConn:               codebuild.New(c.sessionForService(sess, names.CodeBuild)),
+		CodeCommitConn:              codecommit.New(c.sessionForService(sess, names.CodeCommit)),
+		CodeGuruProfilerConn:        codeguruprofiler.New(c.sessionForService(sess, names.CodeGuruProfiler)),
+		CodeGuruReviewerConn:        codegurureviewer.New(c.sessionForService(sess, names.CodeGuruReviewer)),
+		CodePipelineConn:            codepipeline.New(c.sessionForService(sess, names.CodePipeline)),
+		CodeStarConn:                codestar.New(c.sessionForService(sess, names.CodeStar)),
+		CodeStarConnectionsConn:     codestarconnections.New(c.sessionForService(sess, names.CodeStarConnections)),
+		CodeStarNotificationsConn:   codestarnotifications.New(c.sessionForService(sess, names.CodeStarNotifications)),
+		CognitoIDPConn:              cognitoidentityprovider.New(c.sessionForService(sess, names.CognitoIDP)),
+		CognitoIdentityConn:         cognitoidentity.New(c.sessionForService(sess, names.CognitoIdentity)),
+		CognitoSyncConn:             cognitosync.New(c.sessionForService(sess, names.CognitoSync)),
+		ComprehendConn:              comprehend.New(c.sessionForService(sess, names.Comprehend)),
+		ComprehendMedicalConn:       comprehendmedical.New(c.sessionForService(sess, names.ComprehendMedical)),
+		ComputeOptimizerConn:        computeoptimizer.New(c.sessionForService(sess, names.ComputeOptimizer)),
+		ConfigServiceConn:           configservice.New(c.sessionForService(sess, names.ConfigService)),
+		ConnectConn:                 connect.New(c.sessionForService(sess, names.Connect)),
+		ConnectContactLensConn:      connectcontactlens.New(c.sessionForService(sess, names.ConnectContactLens)),
+		ConnectParticipantConn:      connectparticipant.New(c.sessionForService(sess, names.ConnectParticipant)),
+		CustomerProfilesConn:        customerprofiles.New(c.sessionForService(sess, names.CustomerProfiles)),
+		DAXConn:                     dax.New(c.sessionForService(sess, names.DAX)),
+		DLMConn:                     dlm.New(c.sessionForService(sess, names.DLM)),
+		DMSConn:                     databasemigrationservice.New(c.sessionForService(sess, names.DMS)),
+		DRSConn:                     drs.New(c.sessionForService(sess, names.DRS)),
+		DSConn:                      directoryservice.New(c.sessionForService(sess, names.DS)),
+		DataBrewConn:                gluedatabrew.New(c.sessionForService(sess, names.DataBrew)),
+		DataExchangeConn:            dataexchange.New(c.sessionForService(sess, names.DataExchange)),
+		DataPipelineConn:            datapipeline.New(c.sessionForService(sess, names.DataPipeline)),
+		DataSyncConn:                datasync.New(c.sessionForService(sess, names.DataSync)),
+		DeployConn:                  codedeploy.New(c.sessionForService(sess, names.Deploy)),
+		DetectiveConn:               detective.New(c.sessionForService(sess, names.Detective)),
+		DevOpsGuruConn:              devopsguru.New(c.sessionForService(sess, names.DevOpsGuru)),
+		DeviceFarmConn:              devicefarm.New(c.sessionForService(sess, names.DeviceFarm)),
+		DirectConnectConn:           directconnect.New(c.sessionForService(sess, names.DirectConnect)),
+		DiscoveryConn:               applicationdiscoveryservice.New(c.sessionForService(sess, names.Discovery)),
+		DocDBConn:                   docdb.New(c.sessionForService(sess, names.DocDB)),
+		DynamoDBConn:                dynamodb.New(c.sessionForService(sess, names.DynamoDB)),
+		DynamoDBStreamsConn:         dynamodbstreams.New(c.sessionForService(sess, names.DynamoDBStreams)),
+		EBSConn:                     ebs.New(c.sessionForService(sess, names.EBS)),
+		EC2Conn:                     ec2.New(c.sessionForService(sess, names.EC2)),
+		EC2InstanceConnectConn:      ec2instanceconnect.New(c.sessionForService(sess, names.EC2InstanceConnect)),
+		ECRConn:                     ecr.New(c.sessionForService(sess, names.ECR)),
+		ECRPublicConn:               ecrpublic.New(c.sessionForService(sess, names.ECRPublic)),
+		ECSConn:                     ecs.New(c.sessionForService(sess, names.ECS)),
+		EFSConn:                     efs.New(c.sessionForService(sess, names.EFS)),
+		EKSConn:                     eks.New(c.sessionForService(sess, names.EKS)),
+		ELBConn:                     elb.New(c.sessionForService(sess, names.ELB)),
+		ELBV2Conn:                   elbv2.New(c.sessionForService(sess, names.ELBV2)),
+		EMRConn:                     emr.New(c.sessionForService(sess, names.EMR)),
+		EMRContainersConn:           emrcontainers.New(c.sessionForService(sess, names.EMRContainers)),
+		EMRServerlessConn:           emrserverless.New(c.sessionForService(sess, names.EMRServerless)),
+		ElastiCacheConn:             elasticache.New(c.sessionForService(sess, names.ElastiCache)),
+		ElasticBeanstalkConn:        elasticbeanstalk.New(c.sessionForService(sess, names.ElasticBeanstalk)),
+		ElasticInferenceConn:        elasticinference.New(c.sessionForService(sess, names.ElasticInference)),
+		ElasticTranscoderConn:       elastictranscoder.New(c.sessionForService(sess, names.ElasticTranscoder)),
+		ElasticsearchConn:           elasticsearchservice.New(c.sessionForService(sess, names.Elasticsearch)),
+		EventsConn:                  eventbridge.New(c.sessionForService(sess, names.Events)),
+		EvidentlyConn:               cloudwatchevidently.New(c.sessionForService(sess, names.Evidently)),
+		FISConn:                     fis.New(c.sessionForService(sess, names.FIS)),
+		FMSConn:                     fms.New(c.sessionForService(sess, names.FMS)),
+		FSxConn:                     fsx.New(c.sessionForService(sess, names.FSx)),
+		FinSpaceConn:                finspace.New(c.sessionForService(sess, names.FinSpace)),
+		FinSpaceDataConn:            finspacedata.New(c.sessionForService(sess, names.FinSpaceData)),
+		FirehoseConn:                firehose.New(c.sessionForService(sess, names.Firehose)),
+		ForecastConn:                forecastservice.New(c.sessionForService(sess, names.Forecast)),
+		ForecastQueryConn:           forecastqueryservice.New(c.sessionForService(sess, names.ForecastQuery)),
+		FraudDetectorConn:           frauddetector.New(c.sessionForService(sess, names.FraudDetector)),
+		GameLiftConn:                gamelift.New(c.sessionForService(sess, names.GameLift)),
+		GlacierConn:                 glacier.New(c.sessionForService(sess, names.Glacier)),
+		GlueConn:                    glue.New(c.sessionForService(sess, names.Glue)),
+		GrafanaConn:                 managedgrafana.New(c.sessionForService(sess, names.Grafana)),
+		GreengrassConn:              greengrass.New(c.sessionForService(sess, names.Greengrass)),
+		GreengrassV2Conn:            greengrassv2.New(c.sessionForService(sess, names.GreengrassV2)),
+		GroundStationConn:           groundstation.New(c.sessionForService(sess, names.GroundStation)),
+		GuardDutyConn:               guardduty.New(c.sessionForService(sess, names.GuardDuty)),
+		HealthConn:                  health.New(c.sessionForService(sess, names.Health)),
+		HealthLakeConn:              healthlake.New(c.sessionForService(sess, names.HealthLake)),
+		HoneycodeConn:               honeycode.New(c.sessionForService(sess, names.Honeycode)),
+		IAMConn:                     iam.New(c.sessionForService(sess, names.IAM)),
+		IVSConn:                     ivs.New(c.sessionForService(sess, names.IVS)),
+		IdentityStoreConn:           identitystore.New(c.sessionForService(sess, names.IdentityStore)),
+		ImageBuilderConn:            imagebuilder.New(c.sessionForService(sess, names.ImageBuilder)),
+		InspectorConn:               inspector.New(c.sessionForService(sess, names.Inspector)),
+		Inspector2Conn:              inspector2.New(c.sessionForService(sess, names.Inspector2)),
+		IoTConn:                     iot.New(c.sessionForService(sess, names.IoT)),
+		IoT1ClickDevicesConn:        iot1clickdevicesservice.New(c.sessionForService(sess, names.IoT1ClickDevices)),
+		IoT1ClickProjectsConn:       iot1clickprojects.New(c.sessionForService(sess, names.IoT1ClickProjects)),
+		IoTAnalyticsConn:            iotanalytics.New(c.sessionForService(sess, names.IoTAnalytics)),
+		IoTDataConn:                 iotdataplane.New(c.sessionForService(sess, names.IoTData)),
+		IoTDeviceAdvisorConn:        iotdeviceadvisor.New(c.sessionForService(sess, names.IoTDeviceAdvisor)),
+		IoTEventsConn:               iotevents.New(c.sessionForService(sess, names.IoTEvents)),
+		IoTEventsDataConn:           ioteventsdata.New(c.sessionForService(sess, names.IoTEventsData)),
+		IoTFleetHubConn:             iotfleethub.New(c.sessionForService(sess, names.IoTFleetHub)),
+		IoTJobsDataConn:             iotjobsdataplane.New(c.sessionForService(sess, names.IoTJobsData)),
+		IoTSecureTunnelingConn:      iotsecuretunneling.New(c.sessionForService(sess, names.IoTSecureTunneling)),
+		IoTSiteWiseConn:             iotsitewise.New(c.sessionForService(sess, names.IoTSiteWise)),
+		IoTThingsGraphConn:          iotthingsgraph.New(c.sessionForService(sess, names.IoTThingsGraph)),
+		IoTTwinMakerConn:            iottwinmaker.New(c.sessionForService(sess, names.IoTTwinMaker)),
+		IoTWirelessConn:             iotwireless.New(c.sessionForService(sess, names.IoTWireless)),
+		KMSConn:                     kms.New(c.sessionForService(sess, names.KMS)),
+		KafkaConn:                   kafka.New(c.sessionForService(sess, names.Kafka)),
+		KafkaConnectConn:            kafkaconnect.New(c.sessionForService(sess, names.KafkaConnect)),
+		KendraConn: kendra.NewFromConfig(cfg, func(o *kendra.Options) {
+			if endpoint := c.Endpoints[names.Kendra]; endpoint != "" {
+				o.EndpointResolver = kendra.EndpointResolverFromURL(endpoint)
+			}
+			if l, ok := c.rateLimiters[names.Kendra]; ok {
+				o.APIOptions = append(o.APIOptions, l.addMiddleware)
+			}
+		}),
+		KeyspacesConn:                    keyspaces.New(c.sessionForService(sess, names.Keyspaces)),
+		KinesisConn:                      kinesis.New(c.sessionForService(sess, names.Kinesis)),
+		KinesisAnalyticsConn:             kinesisanalytics.New(c.sessionForService(sess, names.KinesisAnalytics)),
+		KinesisAnalyticsV2Conn:           kinesisanalyticsv2.New(c.sessionForService(sess, names.KinesisAnalyticsV2)),
+		KinesisVideoConn:                 kinesisvideo.New(c.sessionForService(sess, names.KinesisVideo)),
+		KinesisVideoArchivedMediaConn:    kinesisvideoarchivedmedia.New(c.sessionForService(sess, names.KinesisVideoArchivedMedia)),
+		KinesisVideoMediaConn:            kinesisvideomedia.New(c.sessionForService(sess, names.KinesisVideoMedia)),
+		KinesisVideoSignalingConn:        kinesisvideosignalingchannels.New(c.sessionForService(sess, names.KinesisVideoSignaling)),
+		LakeFormationConn:                lakeformation.New(c.sessionForService(sess, names.LakeFormation)),
+		LambdaConn:                       lambda.New(c.sessionForService(sess, names.Lambda)),
+		LexModelsConn:                    lexmodelbuildingservice.New(c.sessionForService(sess, names.LexModels)),
+		LexModelsV2Conn:                  lexmodelsv2.New(c.sessionForService(sess, names.LexModelsV2)),
+		LexRuntimeConn:                   lexruntimeservice.New(c.sessionForService(sess, names.LexRuntime)),
+		LexRuntimeV2Conn:                 lexruntimev2.New(c.sessionForService(sess, names.LexRuntimeV2)),
+		LicenseManagerConn:               licensemanager.New(c.sessionForService(sess, names.LicenseManager)),
+		LightsailConn:                    lightsail.New(c.sessionForService(sess, names.Lightsail)),
+		LocationConn:                     locationservice.New(c.sessionForService(sess, names.Location)),
+		LogsConn:                         cloudwatchlogs.New(c.sessionForService(sess, names.Logs)),
+		LookoutEquipmentConn:             lookoutequipment.New(c.sessionForService(sess, names.LookoutEquipment)),
+		LookoutMetricsConn:               lookoutmetrics.New(c.sessionForService(sess, names.LookoutMetrics)),
+		LookoutVisionConn:                lookoutforvision.New(c.sessionForService(sess, names.LookoutVision)),
+		MQConn:                           mq.New(c.sessionForService(sess, names.MQ)),
+		MTurkConn:                        mturk.New(c.sessionForService(sess, names.MTurk)),
+		MWAAConn:                         mwaa.New(c.sessionForService(sess, names.MWAA)),
+		MachineLearningConn:              machinelearning.New(c.sessionForService(sess, names.MachineLearning)),
+		MacieConn:                        macie.New(c.sessionForService(sess, names.Macie)),
+		Macie2Conn:                       macie2.New(c.sessionForService(sess, names.Macie2)),
+		ManagedBlockchainConn:            managedblockchain.New(c.sessionForService(sess, names.ManagedBlockchain)),
+		MarketplaceCatalogConn:           marketplacecatalog.New(c.sessionForService(sess, names.MarketplaceCatalog)),
+		MarketplaceCommerceAnalyticsConn: marketplacecommerceanalytics.New(c.sessionForService(sess, names.MarketplaceCommerceAnalytics)),
+		MarketplaceEntitlementConn:       marketplaceentitlementservice.New(c.sessionForService(sess, names.MarketplaceEntitlement)),
+		MarketplaceMeteringConn:          marketplacemetering.New(c.sessionForService(sess, names.MarketplaceMetering)),
+		MediaConnectConn:                 mediaconnect.New(c.sessionForService(sess, names.MediaConnect)),
+		MediaConvertConn:                 mediaconvert.New(c.sessionForService(sess, names.MediaConvert)),
+		MediaLiveConn:                    medialive.New(c.sessionForService(sess, names.MediaLive)),
+		MediaPackageConn:                 mediapackage.New(c.sessionForService(sess, names.MediaPackage)),
+		MediaPackageVODConn:              mediapackagevod.New(c.sessionForService(sess, names.MediaPackageVOD)),
+		MediaStoreConn:                   mediastore.New(c.sessionForService(sess, names.MediaStore)),
+		MediaStoreDataConn:               mediastoredata.New(c.sessionForService(sess, names.MediaStoreData)),
+		MediaTailorConn:                  mediatailor.New(c.sessionForService(sess, names.MediaTailor)),
+		MemoryDBConn:                     memorydb.New(c.sessionForService(sess, names.MemoryDB)),
+		MgHConn:                          migrationhub.New(c.sessionForService(sess, names.MgH)),
+		MgnConn:                          mgn.New(c.sessionForService(sess, names.Mgn)),
+		MigrationHubConfigConn:           migrationhubconfig.New(c.sessionForService(sess, names.MigrationHubConfig)),
+		MigrationHubRefactorSpacesConn:   migrationhubrefactorspaces.New(c.sessionForService(sess, names.MigrationHubRefactorSpaces)),
+		MigrationHubStrategyConn:         migrationhubstrategyrecommendations.New(c.sessionForService(sess, names.MigrationHubStrategy)),
+		MobileConn:                       mobile.New(c.sessionForService(sess, names.Mobile)),
+		NeptuneConn:                      neptune.New(c.sessionForService(sess, names.Neptune)),
+		NetworkFirewallConn:              networkfirewall.New(c.sessionForService(sess, names.NetworkFirewall)),
+		NetworkManagerConn:               networkmanager.New(c.sessionForService(sess, names.NetworkManager)),
+		NimbleConn:                       nimblestudio.New(c.sessionForService(sess, names.Nimble)),
+		OpenSearchConn:                   opensearchservice.New(c.sessionForService(sess, names.OpenSearch)),
+		OpsWorksConn:                     opsworks.New(c.sessionForService(sess, names.OpsWorks)),
+		OpsWorksCMConn:                   opsworkscm.New(c.sessionForService(sess, names.OpsWorksCM)),
+		OrganizationsConn:                organizations.New(c.sessionForService(sess, names.Organizations)),
+		OutpostsConn:                     outposts.New(c.sessionForService(sess, names.Outposts)),
+		PIConn:                           pi.New(c.sessionForService(sess, names.PI)),
+		PanoramaConn:                     panorama.New(c.sessionForService(sess, names.Panorama)),
+		PersonalizeConn:                  personalize.New(c.sessionForService(sess, names.Personalize)),
+		PersonalizeEventsConn:            personalizeevents.New(c.sessionForService(sess, names.PersonalizeEvents)),
+		PersonalizeRuntimeConn:           personalizeruntime.New(c.sessionForService(sess, names.PersonalizeRuntime)),
+		PinpointConn:                     pinpoint.New(c.sessionForService(sess, names.Pinpoint)),
+		PinpointEmailConn:                pinpointemail.New(c.sessionForService(sess, names.PinpointEmail)),
+		PinpointSMSVoiceConn:             pinpointsmsvoice.New(c.sessionForService(sess, names.PinpointSMSVoice)),
+		PollyConn:                        polly.New(c.sessionForService(sess, names.Polly)),
+		PricingConn:                      pricing.New(c.sessionForService(sess, names.Pricing)),
+		ProtonConn:                       proton.New(c.sessionForService(sess, names.Proton)),
+		QLDBConn:                         qldb.New(c.sessionForService(sess, names.QLDB)),
+		QLDBSessionConn:                  qldbsession.New(c.sessionForService(sess, names.QLDBSession)),
+		QuickSightConn:                   quicksight.New(c.sessionForService(sess, names.QuickSight)),
+		RAMConn:                          ram.New(c.sessionForService(sess, names.RAM)),
+		RBinConn:                         recyclebin.New(c.sessionForService(sess, names.RBin)),
+		RDSConn:                          rds.New(c.sessionForService(sess, names.RDS)),
+		RDSDataConn:                      rdsdataservice.New(c.sessionForService(sess, names.RDSData)),
+		RUMConn:                          cloudwatchrum.New(c.sessionForService(sess, names.RUM)),
+		RedshiftConn:                     redshift.New(c.sessionForService(sess, names.Redshift)),
+		RedshiftDataConn:                 redshiftdataapiservice.New(c.sessionForService(sess, names.RedshiftData)),
+		RekognitionConn:                  rekognition.New(c.sessionForService(sess, names.Rekognition)),
+		ResilienceHubConn:                resiliencehub.New(c.sessionForService(sess, names.ResilienceHub)),
+		ResourceGroupsConn:               resourcegroups.New(c.sessionForService(sess, names.ResourceGroups)),
+		ResourceGroupsTaggingAPIConn:     resourcegroupstaggingapi.New(c.sessionForService(sess, names.ResourceGroupsTaggingAPI)),
+		RoboMakerConn:                    robomaker.New(c.sessionForService(sess, names.RoboMaker)),
+		Route53RecoveryClusterConn:       route53recoverycluster.New(c.sessionForService(sess, names.Route53RecoveryCluster)),
+		Route53ResolverConn:              route53resolver.New(c.sessionForService(sess, names.Route53Resolver)),
+		S3ControlConn:                    s3control.New(c.sessionForService(sess, names.S3Control)),
+		S3OutpostsConn:                   s3outposts.New(c.sessionForService(sess, names.S3Outposts)),
+		SESConn:                          ses.New(c.sessionForService(sess, names.SES)),
+		SESV2Conn:                        sesv2.New(c.sessionForService(sess, names.SESV2)),
+		SFNConn:                          sfn.New(c.sessionForService(sess, names.SFN)),
+		SMSConn:                          sms.New(c.sessionForService(sess, names.SMS)),
+		SNSConn:                          sns.New(c.sessionForService(sess, names.SNS)),
+		SQSConn:                          sqs.New(c.sessionForService(sess, names.SQS)),
+		SSMConn:                          ssm.New(c.sessionForService(sess, names.SSM)),
+		SSMContactsConn:                  ssmcontacts.New(c.sessionForService(sess, names.SSMContacts)),
+		SSMIncidentsConn:                 ssmincidents.New(c.sessionForService(sess, names.SSMIncidents)),
+		SSOConn:                          sso.New(c.sessionForService(sess, names.SSO)),
+		SSOAdminConn:                     ssoadmin.New(c.sessionForService(sess, names.SSOAdmin)),
+		SSOOIDCConn:                      ssooidc.New(c.sessionForService(sess, names.SSOOIDC)),
+		SWFConn:                          swf.New(c.sessionForService(sess, names.SWF)),
+		SageMakerConn:                    sagemaker.New(c.sessionForService(sess, names.SageMaker)),
+		SageMakerA2IRuntimeConn:          augmentedairuntime.New(c.sessionForService(sess, names.SageMakerA2IRuntime)),
+		SageMakerEdgeConn:                sagemakeredgemanager.New(c.sessionForService(sess, names.SageMakerEdge)),
+		SageMakerFeatureStoreRuntimeConn: sagemakerfeaturestoreruntime.New(c.sessionForService(sess, names.SageMakerFeatureStoreRuntime)),
+		SageMakerRuntimeConn:             sagemakerruntime.New(c.sessionForService(sess, names.SageMakerRuntime)),
+		SavingsPlansConn:                 savingsplans.New(c.sessionForService(sess, names.SavingsPlans)),
+		SchemasConn:                      schemas.New(c.sessionForService(sess, names.Schemas)),
+		SecretsManagerConn:               secretsmanager.New(c.sessionForService(sess, names.SecretsManager)),
+		SecurityHubConn:                  securityhub.New(c.sessionForService(sess, names.SecurityHub)),
+		ServerlessRepoConn:               serverlessapplicationrepository.New(c.sessionForService(sess, names.ServerlessRepo)),
+		ServiceCatalogConn:               servicecatalog.New(c.sessionForService(sess, names.ServiceCatalog)),
+		ServiceCatalogAppRegistryConn:    appregistry.New(c.sessionForService(sess, names.ServiceCatalogAppRegistry)),
+		ServiceDiscoveryConn:             servicediscovery.New(c.sessionForService(sess, names.ServiceDiscovery)),
+		ServiceQuotasConn:                servicequotas.New(c.sessionForService(sess, names.ServiceQuotas)),
+		SignerConn:                       signer.New(c.sessionForService(sess, names.Signer)),
+		SimpleDBConn:                     simpledb.New(c.sessionForService(sess, names.SimpleDB)),
+		SnowDeviceManagementConn:         snowdevicemanagement.New(c.sessionForService(sess, names.SnowDeviceManagement)),
+		SnowballConn:                     snowball.New(c.sessionForService(sess, names.Snowball)),
+		StorageGatewayConn:               storagegateway.New(c.sessionForService(sess, names.StorageGateway)),
+		SupportConn:                      support.New(c.sessionForService(sess, names.Support)),
+		SyntheticsConn:                   synthetics.New(c.sessionForService(sess, names.Synthetics)),
+		TextractConn:                     textract.New(c.sessionForService(sess, names.Textract)),
+		TimestreamQueryConn:              timestreamquery.New(c.sessionForService(sess, names.TimestreamQuery)),
+		TimestreamWriteConn:              timestreamwrite.New(c.sessionForService(sess, names.TimestreamWrite)),
+		TranscribeConn:                   transcribeservice.New(c.sessionForService(sess, names.Transcribe)),
+		TranscribeStreamingConn:          transcribestreamingservice.New(c.sessionForService(sess, names.TranscribeStreaming)),
+		TransferConn:                     transfer.New(c.sessionForService(sess, names.Transfer)),
+		TranslateConn:                    translate.New(c.sessionForService(sess, names.Translate)),
+		VoiceIDConn:                      voiceid.New(c.sessionForService(sess, names.VoiceID)),
+		WAFConn:                          waf.New(c.sessionForService(sess, names.WAF)),
+		WAFRegionalConn:                  wafregional.New(c.sessionForService(sess, names.WAFRegional)),
+		WAFV2Conn:                        wafv2.New(c.sessionForService(sess, names.WAFV2)),
+		WellArchitectedConn:              wellarchitected.New(c.sessionForService(sess, names.WellArchitected)),
+		WisdomConn:                       connectwisdomservice.New(c.sessionForService(sess, names.Wisdom)),
+		WorkDocsConn:                     workdocs.New(c.sessionForService(sess, names.WorkDocs)),
+		WorkLinkConn:                     worklink.New(c.sessionForService(sess, names.WorkLink)),
+		WorkMailConn:                     workmail.New(c.sessionForService(sess, names.WorkMail)),
+		WorkMailMessageFlowConn:          workmailmessageflow.New(c.sessionForService(sess, names.WorkMailMessageFlow)),
+		WorkSpacesConn:                   workspaces.New(c.sessionForService(sess, names.WorkSpaces)),
+		WorkSpacesWebConn:                workspacesweb.New(c.sessionForService(sess, names.WorkSpacesWeb)),
+		XRayConn:                         xray.New(c.sessionForService(sess, names.XRay)),
 	}
 }
+
+// addRetryableErrorsHandlers adds the retryable errors declared in names_data.csv to AWS SDK for Go v1 clients.
+func (c *Config) addRetryableErrorsHandlers(client *AWSClient) {
+	client.APIGatewayConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "ConflictException", Message: "try again later"},
+	))
+	client.AppAutoScalingConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"Describe*", "List*"}, Code: "FailedResourceAccessException"},
+	))
+	client.AppConfigConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"StartDeployment"}, Code: "ConflictException"},
+	))
+	client.AppSyncConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"CreateGraphqlApi"}, Code: "ConcurrentModificationException", Message: "a GraphQL API creation is already in progress"},
+	))
+	client.ChimeConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"CreateVoiceConnector"}, Code: "BadRequestException", Message: "Service received a bad request"},
+	))
+	client.CloudFormationConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "OperationInProgressException", Message: "Another Operation on StackSet"},
+	))
+	client.CloudHSMV2Conn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "CloudHsmInternalFailureException", Message: "request was rejected because of an AWS CloudHSM internal failure"},
+	))
+	client.ConfigServiceConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"DeleteOrganizationConformancePack"}, Code: "ResourceInUseException"},
+	))
+	client.DynamoDBConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"PutItem", "UpdateItem", "DeleteItem"}, Code: "LimitExceededException", Message: "Subscriber limit exceeded:"},
+	))
+	client.EC2Conn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"AttachVpnGateway", "DetachVpnGateway"}, Code: "InvalidParameterValue", Message: "This call cannot be completed because there are pending VPNs or Virtual Interfaces"},
+		names.RetryableError{Operations: []string{"CreateClientVpnEndpoint"}, Code: "OperationNotPermitted", Message: "Endpoint cannot be created while another endpoint is being created"},
+		names.RetryableError{Operations: []string{"CreateClientVpnRoute", "DeleteClientVpnRoute"}, Code: "ConcurrentMutationLimitExceeded", Message: "Cannot initiate another change for this endpoint at this time"},
+		names.RetryableError{Operations: []string{"CreateVpnConnection"}, Code: "VpnConnectionLimitExceeded", Message: "maximum number of mutating objects has been reached"},
+		names.RetryableError{Operations: []string{"CreateVpnGateway"}, Code: "VpnGatewayLimitExceeded", Message: "maximum number of mutating objects has been reached"},
+	))
+	client.FMSConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"AssociateAdminAccount"}, Code: "InvalidOperationException", Message: "Your AWS Organization is currently offboarding with AWS Firewall Manager. Please submit onboard request after offboarded."},
+		names.RetryableError{Operations: []string{"DisassociateAdminAccount"}, Code: "InvalidOperationException", Message: "Your AWS Organization is currently onboarding with AWS Firewall Manager and cannot be offboarded."},
+		names.RetryableError{Operations: []string{"PutPolicy"}, Code: "InternalErrorException"},
+	))
+	client.KafkaConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "TooManyRequestsException", Message: "Too Many Requests"},
+	))
+	client.KinesisConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"CreateStream"}, Code: "LimitExceededException", Message: "simultaneously be in CREATING or DELETING"},
+		names.RetryableError{Operations: []string{"CreateStream", "DeleteStream"}, Code: "LimitExceededException", Message: "Rate exceeded for stream"},
+	))
+	client.LightsailConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"CreateContainerService", "UpdateContainerService", "CreateContainerServiceDeployment", "DeleteContainerService"}, Code: "InvalidInputException", Message: "Please try again in a few minutes"},
+		names.RetryableError{Operations: []string{"DeleteContainerService"}, Code: "InvalidInputException", Message: "Please wait for it to complete before trying again"},
+	))
+	client.OrganizationsConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "ConcurrentModificationException", Message: "Try again later"},
+	))
+	client.S3Conn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "OperationAborted", Message: "A conflicting conditional operation is currently in progress against this resource. Please try again."},
+	))
+	client.SSOAdminConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"AttachManagedPolicyToPermissionSet", "DetachManagedPolicyFromPermissionSet"}, Code: "ConflictException"},
+	))
+	client.SecurityHubConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Operations: []string{"EnableOrganizationAdminAccount"}, Code: "ResourceConflictException"},
+	))
+	client.StorageGatewayConn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "InvalidGatewayRequestException", Message: "The specified gateway proxy network connection is busy"},
+	))
+	client.WAFV2Conn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		names.RetryableError{Code: "WAFInternalErrorException", Message: "Retry your request"},
+		names.RetryableError{Code: "WAFServiceLinkedRoleErrorException", Message: "Retry"},
+		names.RetryableError{Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"}, Code: "WAFTagOperationException", Message: "Retry your request"},
+		names.RetryableError{Operations: []string{"CreateIPSet", "CreateRegexPatternSet", "CreateRuleGroup", "CreateWebACL"}, Code: "WAFTagOperationInternalErrorException", Message: "Retry your request"},
+	))
+}
@@ -1,10 +1,14 @@
 package conns
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -12,6 +16,98 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+type resourceTypeNameContextKey struct{}
+
+// NewResourceTypeNameContext returns a copy of the context carrying the Terraform resource type name, e.g. "aws_instance".
+func NewResourceTypeNameContext(ctx context.Context, typeName string) context.Context {
+	return context.WithValue(ctx, resourceTypeNameContextKey{}, typeName)
+}
+
+// ResourceTypeNameFromContext returns the Terraform resource type name carried by the context, if any.
+func ResourceTypeNameFromContext(ctx context.Context) (string, bool) {
+	typeName, ok := ctx.Value(resourceTypeNameContextKey{}).(string)
+
+	return typeName, ok
+}
+
+const resourceTypeNameHandlerName = "terraform-provider-aws.ResourceTypeNameHandler"
+
+// resourceTypeClients caches the clients returned by ForResourceType, keyed by resource type name.
+// It is only used when API call metrics are recorded, as only they need the resource type name of requests.
+type resourceTypeClients struct {
+	mu      sync.Mutex
+	clients map[string]*AWSClient
+}
+
+func newResourceTypeClients() *resourceTypeClients {
+	return &resourceTypeClients{
+		clients: make(map[string]*AWSClient),
+	}
+}
+
+// ForResourceType returns the client for use by resources of the given type, e.g. "aws_instance",
+// with any resource type specific provider configuration, such as default tags, applied.
+// When API call metrics are recorded, its AWS SDK for Go v1 service clients add the resource type name
+// to the context of requests made without one, e.g. by resources using Create rather than CreateContext.
+func (client *AWSClient) ForResourceType(typeName string) *AWSClient {
+	if client == nil {
+		return nil
+	}
+
+	if cache := client.resourceTypeClients; cache != nil {
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+
+		if c, ok := cache.clients[typeName]; ok {
+			return c
+		}
+
+		c := *client
+		c.DefaultTagsConfig = client.DefaultTagsConfig.ForResourceType(typeName)
+		c.addServiceClientHandler(resourceTypeNameHandler(typeName))
+		cache.clients[typeName] = &c
+
+		return &c
+	}
+
+	defaultTagsConfig := client.DefaultTagsConfig.ForResourceType(typeName)
+
+	if defaultTagsConfig == client.DefaultTagsConfig {
+		return client
+	}
+
+	c := *client
+	c.DefaultTagsConfig = defaultTagsConfig
+
+	return &c
+}
+
+// resourceTypeNameHandler is an AWS SDK for Go v1 request handler that adds the resource type name
+// to the context of requests without one.
+func resourceTypeNameHandler(typeName string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: resourceTypeNameHandlerName,
+		Fn: func(r *request.Request) {
+			if _, ok := ResourceTypeNameFromContext(r.Context()); !ok {
+				r.SetContext(NewResourceTypeNameContext(r.Context(), typeName))
+			}
+		},
+	}
+}
+
+// withValidateHandler returns a copy of the AWS SDK for Go v1 client which runs the handler first when validating requests.
+func withValidateHandler(c *client.Client, handler request.NamedHandler) *client.Client {
+	if c == nil {
+		return nil
+	}
+
+	cc := *c
+	cc.Handlers = c.Handlers.Copy()
+	cc.Handlers.Validate.PushFrontNamed(handler)
+
+	return &cc
+}
+
 func NewSessionForRegion(cfg *aws.Config, region, terraformVersion string) (*session.Session, error) {
 	session, err := session.NewSession(cfg)
 
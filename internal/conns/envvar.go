@@ -54,6 +54,22 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	EnvVarAccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For recording AWS API interactions during acceptance testing or replaying them without AWS access
+	// Valid values are "record" and "replay"
+	EnvVarAccRecordMode = "TF_ACC_RECORD_MODE"
+
+	// The directory containing recorded AWS API interactions, relative to the package under test
+	// Defaults to testdata/cassettes
+	EnvVarAccCassetteDir = "TF_ACC_CASSETTE_DIR"
+
+	// For tests run against a local stand-in for AWS, e.g. LocalStack or Moto, the URL of its endpoint
+	// All service endpoints are overridden with this URL
+	EnvVarAccLocalEndpoint = "TF_ACC_LOCAL_ENDPOINT"
+
+	// For tests run against a local stand-in for AWS, a comma-separated list of the services it supports
+	// Tests requiring other services are skipped. Defaults to all services
+	EnvVarAccLocalEndpointServices = "TF_ACC_LOCAL_ENDPOINT_SERVICES"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -0,0 +1,298 @@
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	apiCallMetricsHandlerName = "terraform-provider-aws.APICallMetricsHandler"
+
+	apiCallMetricsRecordTypeCall    = "call"
+	apiCallMetricsRecordTypeSummary = "summary"
+)
+
+// APICallMetric is the record written for each completed AWS API call.
+type APICallMetric struct {
+	Type         string    `json:"type"`
+	Time         time.Time `json:"time"`
+	ResourceType string    `json:"resource_type,omitempty"`
+	Service      string    `json:"service"`
+	Operation    string    `json:"operation"`
+	Region       string    `json:"region,omitempty"`
+	LatencyMS    int64     `json:"latency_ms"`
+	RetryCount   int       `json:"retry_count"`
+	Throttled    bool      `json:"throttled"`
+	StatusCode   int       `json:"status_code,omitempty"`
+	ErrorCode    string    `json:"error_code,omitempty"`
+}
+
+// APICallMetricsSummary is the record written per resource type and service operation when metrics recording ends.
+// Calls made outside of a resource operation have an empty resource type.
+type APICallMetricsSummary struct {
+	Type           string `json:"type"`
+	ResourceType   string `json:"resource_type,omitempty"`
+	Service        string `json:"service"`
+	Operation      string `json:"operation"`
+	Calls          int    `json:"calls"`
+	Errors         int    `json:"errors"`
+	Retries        int    `json:"retries"`
+	ThrottledCalls int    `json:"throttled_calls"`
+	TotalLatencyMS int64  `json:"total_latency_ms"`
+	MaxLatencyMS   int64  `json:"max_latency_ms"`
+	AvgLatencyMS   int64  `json:"avg_latency_ms"`
+}
+
+// apiCallMetricsRecorder writes API call metrics as JSON lines to a local file.
+type apiCallMetricsRecorder struct {
+	mu        sync.Mutex
+	file      *os.File
+	encoder   *json.Encoder
+	summaries map[string]*APICallMetricsSummary
+	throttles sync.Map // *request.Request -> int
+}
+
+var (
+	apiCallMetricsRecordersMu sync.Mutex
+	apiCallMetricsRecorders   = make(map[string]*apiCallMetricsRecorder)
+)
+
+// apiCallMetricsRecorderForFile returns the recorder for the specified file, creating it if necessary.
+func apiCallMetricsRecorderForFile(path string) (*apiCallMetricsRecorder, error) {
+	apiCallMetricsRecordersMu.Lock()
+	defer apiCallMetricsRecordersMu.Unlock()
+
+	if r, ok := apiCallMetricsRecorders[path]; ok {
+		return r, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+
+	if err != nil {
+		return nil, fmt.Errorf("opening API call metrics file (%s): %w", path, err)
+	}
+
+	r := &apiCallMetricsRecorder{
+		file:      file,
+		encoder:   json.NewEncoder(file),
+		summaries: make(map[string]*APICallMetricsSummary),
+	}
+	apiCallMetricsRecorders[path] = r
+
+	return r, nil
+}
+
+// CloseAPICallMetrics writes the per-operation summaries and closes all API call metrics files.
+// It is called once the provider has stopped serving requests.
+func CloseAPICallMetrics() {
+	apiCallMetricsRecordersMu.Lock()
+	defer apiCallMetricsRecordersMu.Unlock()
+
+	for path, r := range apiCallMetricsRecorders {
+		if err := r.close(); err != nil {
+			log.Printf("[WARN] Error closing API call metrics file (%s): %s", path, err)
+		}
+
+		delete(apiCallMetricsRecorders, path)
+	}
+}
+
+func (r *apiCallMetricsRecorder) record(m APICallMetric) {
+	m.Type = apiCallMetricsRecordTypeCall
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.encoder.Encode(m); err != nil {
+		log.Printf("[WARN] Error writing API call metrics: %s", err)
+	}
+
+	key := m.ResourceType + "." + m.Service + "." + m.Operation
+	s, ok := r.summaries[key]
+
+	if !ok {
+		s = &APICallMetricsSummary{
+			Type:         apiCallMetricsRecordTypeSummary,
+			ResourceType: m.ResourceType,
+			Service:      m.Service,
+			Operation:    m.Operation,
+		}
+		r.summaries[key] = s
+	}
+
+	s.Calls++
+	s.Retries += m.RetryCount
+	s.TotalLatencyMS += m.LatencyMS
+	if m.ErrorCode != "" {
+		s.Errors++
+	}
+	if m.Throttled {
+		s.ThrottledCalls++
+	}
+	if m.LatencyMS > s.MaxLatencyMS {
+		s.MaxLatencyMS = m.LatencyMS
+	}
+}
+
+// summaryList returns the per-operation summaries grouped by resource type and,
+// within each resource type, slowest (by total latency) first.
+func (r *apiCallMetricsRecorder) summaryList() []APICallMetricsSummary {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	summaries := make([]APICallMetricsSummary, 0, len(r.summaries))
+
+	for _, s := range r.summaries {
+		v := *s
+		if v.Calls > 0 {
+			v.AvgLatencyMS = v.TotalLatencyMS / int64(v.Calls)
+		}
+		summaries = append(summaries, v)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].ResourceType != summaries[j].ResourceType {
+			return summaries[i].ResourceType < summaries[j].ResourceType
+		}
+		if summaries[i].TotalLatencyMS != summaries[j].TotalLatencyMS {
+			return summaries[i].TotalLatencyMS > summaries[j].TotalLatencyMS
+		}
+		return summaries[i].Service+summaries[i].Operation < summaries[j].Service+summaries[j].Operation
+	})
+
+	return summaries
+}
+
+func (r *apiCallMetricsRecorder) close() error {
+	summaries := r.summaryList()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, s := range summaries {
+		if err := r.encoder.Encode(s); err != nil {
+			return err
+		}
+	}
+
+	return r.file.Close()
+}
+
+// retryHandler is an AWS SDK for Go v1 request handler that counts throttled attempts.
+func (r *apiCallMetricsRecorder) retryHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: apiCallMetricsHandlerName,
+		Fn: func(req *request.Request) {
+			if !request.IsErrorThrottle(req.Error) {
+				return
+			}
+
+			n, _ := r.throttles.LoadOrStore(req, 0)
+			r.throttles.Store(req, n.(int)+1)
+		},
+	}
+}
+
+// completeHandler is an AWS SDK for Go v1 request handler that records the completed API call.
+func (r *apiCallMetricsRecorder) completeHandler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: apiCallMetricsHandlerName,
+		Fn: func(req *request.Request) {
+			throttles, _ := r.throttles.LoadAndDelete(req)
+			resourceType, _ := ResourceTypeNameFromContext(req.Context())
+
+			m := APICallMetric{
+				Time:         req.Time,
+				ResourceType: resourceType,
+				Service:      req.ClientInfo.ServiceID,
+				Operation:    req.Operation.Name,
+				Region:       req.ClientInfo.SigningRegion,
+				LatencyMS:    time.Since(req.Time).Milliseconds(),
+				RetryCount:   req.RetryCount,
+				Throttled:    throttles != nil || request.IsErrorThrottle(req.Error),
+			}
+
+			if req.HTTPResponse != nil {
+				m.StatusCode = req.HTTPResponse.StatusCode
+			}
+
+			var awsErr awserr.Error
+			if errors.As(req.Error, &awsErr) {
+				m.ErrorCode = awsErr.Code()
+			} else if req.Error != nil {
+				m.ErrorCode = "Unknown"
+			}
+
+			r.record(m)
+		},
+	}
+}
+
+// middleware returns an AWS SDK for Go v2 middleware that records each completed API call.
+func (r *apiCallMetricsRecorder) middleware() middleware.InitializeMiddleware {
+	return middleware.InitializeMiddlewareFunc(apiCallMetricsHandlerName, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		resourceType, _ := ResourceTypeNameFromContext(ctx)
+
+		m := APICallMetric{
+			Time:         start,
+			ResourceType: resourceType,
+			Service:      awsmiddleware.GetServiceID(ctx),
+			Operation:    awsmiddleware.GetOperationName(ctx),
+			Region:       awsmiddleware.GetRegion(ctx),
+			LatencyMS:    time.Since(start).Milliseconds(),
+		}
+
+		if results, ok := retry.GetAttemptResults(metadata); ok {
+			for i, result := range results.Results {
+				if i > 0 {
+					m.RetryCount++
+				}
+				if retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(result.Err).Bool() {
+					m.Throttled = true
+				}
+			}
+		}
+
+		var respErr *smithyhttp.ResponseError
+		if errors.As(err, &respErr) {
+			m.StatusCode = respErr.HTTPStatusCode()
+		} else if resp, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+			m.StatusCode = resp.StatusCode
+		}
+
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			m.ErrorCode = apiErr.ErrorCode()
+		} else if err != nil {
+			m.ErrorCode = "Unknown"
+		}
+
+		r.record(m)
+
+		return out, metadata, err
+	})
+}
+
+// addMiddleware adds the metrics middleware to an AWS SDK for Go v2 middleware stack.
+func (r *apiCallMetricsRecorder) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(r.middleware(), middleware.After)
+}
@@ -0,0 +1,127 @@
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+func TestAPICallMetricsRecorder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+
+	r, err := apiCallMetricsRecorderForFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r.record(APICallMetric{ResourceType: "aws_vpc", Service: "EC2", Operation: "DescribeVpcs", LatencyMS: 10})
+	r.record(APICallMetric{ResourceType: "aws_vpc", Service: "EC2", Operation: "DescribeVpcs", LatencyMS: 30, RetryCount: 2, Throttled: true})
+	r.record(APICallMetric{ResourceType: "aws_vpc", Service: "EC2", Operation: "CreateVpc", LatencyMS: 50})
+	r.record(APICallMetric{ResourceType: "aws_iam_role", Service: "IAM", Operation: "GetRole", LatencyMS: 100, ErrorCode: "NoSuchEntity"})
+	r.record(APICallMetric{ResourceType: "aws_vpc", Service: "IAM", Operation: "GetRole", LatencyMS: 5})
+
+	CloseAPICallMetrics()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	defer file.Close()
+
+	var calls []APICallMetric
+	var summaries []APICallMetricsSummary
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		switch record.Type {
+		case apiCallMetricsRecordTypeCall:
+			var v APICallMetric
+			if err := json.Unmarshal(scanner.Bytes(), &v); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			calls = append(calls, v)
+		case apiCallMetricsRecordTypeSummary:
+			var v APICallMetricsSummary
+			if err := json.Unmarshal(scanner.Bytes(), &v); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			summaries = append(summaries, v)
+		default:
+			t.Fatalf("unexpected record type: %q", record.Type)
+		}
+	}
+
+	if got, expected := len(calls), 5; got != expected {
+		t.Fatalf("got %d calls, expected %d", got, expected)
+	}
+
+	if got, expected := calls[0].ResourceType, "aws_vpc"; got != expected {
+		t.Errorf("got resource type %q, expected %q", got, expected)
+	}
+
+	var got []string
+	for _, s := range summaries {
+		got = append(got, s.ResourceType+" "+s.Service+"."+s.Operation)
+	}
+
+	// Grouped by resource type, slowest first within each resource type.
+	expected := []string{
+		"aws_iam_role IAM.GetRole",
+		"aws_vpc EC2.CreateVpc",
+		"aws_vpc EC2.DescribeVpcs",
+		"aws_vpc IAM.GetRole",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("got summaries %v, expected %v", got, expected)
+	}
+
+	ec2 := summaries[2]
+	if ec2.Calls != 2 || ec2.Retries != 2 || ec2.ThrottledCalls != 1 || ec2.MaxLatencyMS != 30 || ec2.AvgLatencyMS != 20 {
+		t.Errorf("unexpected summary: %+v", ec2)
+	}
+
+	if summaries[0].Errors != 1 {
+		t.Errorf("got %d errors, expected 1", summaries[0].Errors)
+	}
+}
+
+func TestAPICallMetricsRecorderCompleteHandlerResourceType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+
+	r, err := apiCallMetricsRecorderForFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	req := request.New(aws.Config{}, metadata.ClientInfo{ServiceID: "EC2"}, request.Handlers{}, nil, &request.Operation{Name: "DescribeVpcs"}, nil, nil)
+	req.SetContext(NewResourceTypeNameContext(context.Background(), "aws_vpc"))
+
+	r.completeHandler().Fn(req)
+
+	summaries := r.summaryList()
+
+	CloseAPICallMetrics()
+
+	if got, expected := len(summaries), 1; got != expected {
+		t.Fatalf("got %d summaries, expected %d", got, expected)
+	}
+
+	if got, expected := summaries[0].ResourceType, "aws_vpc"; got != expected {
+		t.Errorf("got resource type %q, expected %q", got, expected)
+	}
+}
@@ -0,0 +1,114 @@
+package conns
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const rateLimitHandlerName = "terraform-provider-aws.RateLimitHandler"
+
+// RateLimit is the client-side request rate limit for a service.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimiter is a token bucket shared by all requests made using a service's connections.
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+func newRateLimiter(l RateLimit) *rateLimiter {
+	burst := float64(l.Burst)
+	if burst < 1 {
+		burst = math.Max(1, math.Ceil(l.RequestsPerSecond))
+	}
+
+	return &rateLimiter{
+		rate:   l.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		now:    time.Now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+
+	l.tokens--
+
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns an unused token to the bucket.
+func (l *rateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
+
+// Wait blocks until a request is permitted or the context is done.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+
+	if delay == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// handler returns an AWS SDK for Go v1 request handler that waits for the rate limiter before each attempt is signed.
+func (l *rateLimiter) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: rateLimitHandlerName,
+		Fn: func(r *request.Request) {
+			if err := l.Wait(r.Context()); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
+
+// addMiddleware adds a middleware that waits for the rate limiter before each attempt to an AWS SDK for Go v2 middleware stack.
+func (l *rateLimiter) addMiddleware(stack *middleware.Stack) error {
+	return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(rateLimitHandlerName, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if err := l.Wait(ctx); err != nil {
+			return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+		}
+
+		return next.HandleFinalize(ctx, in)
+	}), "Signing", middleware.Before)
+}
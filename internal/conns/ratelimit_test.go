@@ -0,0 +1,61 @@
+package conns
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(RateLimit{RequestsPerSecond: 2, Burst: 2})
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("request %d: got delay %s, expected none", i, got)
+		}
+	}
+
+	if got, expected := l.reserve(), 500*time.Millisecond; got != expected {
+		t.Errorf("got delay %s, expected %s", got, expected)
+	}
+
+	now = now.Add(2 * time.Second)
+
+	if got := l.reserve(); got != 0 {
+		t.Errorf("got delay %s after refill, expected none", got)
+	}
+}
+
+func TestRateLimiterDefaultBurst(t *testing.T) {
+	testCases := []struct {
+		RateLimit RateLimit
+		Expected  float64
+	}{
+		{RateLimit: RateLimit{RequestsPerSecond: 0.5}, Expected: 1},
+		{RateLimit: RateLimit{RequestsPerSecond: 2.5}, Expected: 3},
+		{RateLimit: RateLimit{RequestsPerSecond: 2.5, Burst: 10}, Expected: 10},
+	}
+
+	for _, testCase := range testCases {
+		if got := newRateLimiter(testCase.RateLimit).burst; got != testCase.Expected {
+			t.Errorf("%+v: got burst %g, expected %g", testCase.RateLimit, got, testCase.Expected)
+		}
+	}
+}
+
+func TestRateLimiterWaitCanceled(t *testing.T) {
+	l := newRateLimiter(RateLimit{RequestsPerSecond: 0.01, Burst: 1})
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Wait(ctx); err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,131 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	// ErrCodeReadOnlyModeEnabled is the error code returned for API operations blocked by the provider's read_only mode.
+	ErrCodeReadOnlyModeEnabled = "ReadOnlyModeEnabled"
+
+	readOnlyHandlerName = "terraform-provider-aws.ReadOnlyHandler"
+)
+
+// readOperationPrefixes are the API operation name prefixes considered not to modify resources.
+// Operations with any other prefix are considered to modify resources, so that unknown operations are blocked.
+var readOperationPrefixes = []string{
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+	// Cryptographic operations used by data sources, e.g. aws_kms_secrets.
+	"Decrypt",
+	"Encrypt",
+}
+
+// batchReadOperationPrefixes are the prefixes, after "Batch", of batch API operations considered not to modify resources.
+var batchReadOperationPrefixes = []string{
+	"Describe",
+	"Get",
+}
+
+type readOnlyExemptOperationsKey struct{}
+
+// WithReadOnlyExemptOperations returns a context in which the named API operations are allowed in read_only mode.
+// Used by data sources whose reads call operations which are otherwise considered to modify resources,
+// e.g. aws_lambda_invocation calling Lambda Invoke, so that data sources keep working.
+func WithReadOnlyExemptOperations(ctx context.Context, operationNames ...string) context.Context {
+	return context.WithValue(ctx, readOnlyExemptOperationsKey{}, operationNames)
+}
+
+// isReadOnlyExemptOperation returns whether the named API operation is allowed in read_only mode in the context.
+func isReadOnlyExemptOperation(ctx context.Context, operationName string) bool {
+	operationNames, _ := ctx.Value(readOnlyExemptOperationsKey{}).([]string)
+
+	for _, name := range operationNames {
+		if name == operationName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsMutatingOperation returns whether the named API operation is considered to modify resources.
+// Only operations with a known read prefix, e.g. "Describe" or "BatchGet", are not.
+func IsMutatingOperation(operationName string) bool {
+	if hasOperationPrefix(operationName, readOperationPrefixes) {
+		return false
+	}
+
+	if name := strings.TrimPrefix(operationName, "Batch"); name != operationName && hasOperationPrefix(name, batchReadOperationPrefixes) {
+		return false
+	}
+
+	return true
+}
+
+// hasOperationPrefix returns whether the API operation name starts with any of the prefixes at a word boundary,
+// e.g. "Getaway" does not start with "Get".
+func hasOperationPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+
+		if rest := name[len(prefix):]; rest == "" || strings.ToUpper(rest[:1]) == rest[:1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readOnlyModeErrorMessage(serviceID, operationName string) string {
+	return fmt.Sprintf("%s %s blocked: the provider is configured with read_only enabled and this operation would modify resources", serviceID, operationName)
+}
+
+// readOnlyHandler is an AWS SDK for Go v1 request handler that rejects mutating API operations.
+var readOnlyHandler = request.NamedHandler{
+	Name: readOnlyHandlerName,
+	Fn: func(r *request.Request) {
+		if !IsMutatingOperation(r.Operation.Name) || isReadOnlyExemptOperation(r.Context(), r.Operation.Name) {
+			return
+		}
+
+		r.Error = awserr.New(ErrCodeReadOnlyModeEnabled, readOnlyModeErrorMessage(r.ClientInfo.ServiceID, r.Operation.Name), nil)
+		r.Retryable = aws.Bool(false)
+	},
+}
+
+// readOnlyMiddleware is an AWS SDK for Go v2 middleware that rejects mutating API operations.
+var readOnlyMiddleware = middleware.InitializeMiddlewareFunc(readOnlyHandlerName, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	if operationName := awsmiddleware.GetOperationName(ctx); IsMutatingOperation(operationName) && !isReadOnlyExemptOperation(ctx, operationName) {
+		return middleware.InitializeOutput{}, middleware.Metadata{}, &smithy.GenericAPIError{
+			Code:    ErrCodeReadOnlyModeEnabled,
+			Message: readOnlyModeErrorMessage(awsmiddleware.GetServiceID(ctx), operationName),
+			Fault:   smithy.FaultClient,
+		}
+	}
+
+	return next.HandleInitialize(ctx, in)
+})
+
+// addReadOnlyMiddleware adds the read-only middleware to an AWS SDK for Go v2 middleware stack.
+func addReadOnlyMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(readOnlyMiddleware, middleware.After)
+}
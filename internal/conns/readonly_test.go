@@ -0,0 +1,145 @@
+package conns
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+func TestIsMutatingOperation(t *testing.T) {
+	testCases := []struct {
+		OperationName string
+		Expected      bool
+	}{
+		{OperationName: "CreateVpc", Expected: true},
+		{OperationName: "PutBucketPolicy", Expected: true},
+		{OperationName: "UpdateFunctionCode", Expected: true},
+		{OperationName: "DeleteRole", Expected: true},
+		{OperationName: "TagResource", Expected: true},
+		{OperationName: "UntagResource", Expected: true},
+		{OperationName: "BatchDeleteImage", Expected: true},
+		{OperationName: "Delete", Expected: true},
+		{OperationName: "DescribeInstances", Expected: false},
+		{OperationName: "GetCallerIdentity", Expected: false},
+		{OperationName: "ListTagsForResource", Expected: false},
+		{OperationName: "BatchGetItem", Expected: false},
+		{OperationName: "BatchDescribeSimulationJob", Expected: false},
+		{OperationName: "HeadBucket", Expected: false},
+		{OperationName: "SearchProducts", Expected: false},
+		{OperationName: "LookupEvents", Expected: false},
+		{OperationName: "SelectObjectContent", Expected: false},
+		{OperationName: "Scan", Expected: false},
+		{OperationName: "Query", Expected: false},
+		{OperationName: "Decrypt", Expected: false},
+		{OperationName: "SettingsDescribe", Expected: true},
+		{OperationName: "Getaway", Expected: true},
+		{OperationName: "BatchWriteItem", Expected: true},
+		{OperationName: "ScheduleKeyDeletion", Expected: true},
+		{OperationName: "ChangeResourceRecordSets", Expected: true},
+		{OperationName: "Invoke", Expected: true},
+		{OperationName: "ExecuteChangeSet", Expected: true},
+		{OperationName: "RequestSpotInstances", Expected: true},
+		{OperationName: "GrantPermissions", Expected: true},
+		{OperationName: "AdminSetUserPassword", Expected: true},
+		{OperationName: "AdminDisableUser", Expected: true},
+		{OperationName: "IncreaseReplicationFactor", Expected: true},
+		{OperationName: "DecreaseReplicationFactor", Expected: true},
+		{OperationName: "UpgradeElasticsearchDomain", Expected: true},
+		{OperationName: "SuspendProcesses", Expected: true},
+		{OperationName: "Subscribe", Expected: true},
+		{OperationName: "Unsubscribe", Expected: true},
+		{OperationName: "", Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.OperationName, func(t *testing.T) {
+			if got := IsMutatingOperation(testCase.OperationName); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestReadOnlyHandlerExemptOperations(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		OperationName string
+		Exempt        []string
+		ExpectBlocked bool
+	}{
+		{Name: "read", OperationName: "GetFunction", ExpectBlocked: false},
+		{Name: "mutating", OperationName: "Invoke", ExpectBlocked: true},
+		{Name: "exempt", OperationName: "Invoke", Exempt: []string{"Invoke"}, ExpectBlocked: false},
+		{Name: "other exempt", OperationName: "DeleteFunction", Exempt: []string{"Invoke"}, ExpectBlocked: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := request.New(aws.Config{}, metadata.ClientInfo{ServiceID: "Lambda"}, request.Handlers{}, nil, &request.Operation{Name: testCase.OperationName}, nil, nil)
+
+			ctx := context.Background()
+			if testCase.Exempt != nil {
+				ctx = WithReadOnlyExemptOperations(ctx, testCase.Exempt...)
+			}
+			r.SetContext(ctx)
+
+			readOnlyHandler.Fn(r)
+
+			if got := tfawserr.ErrCodeEquals(r.Error, ErrCodeReadOnlyModeEnabled); got != testCase.ExpectBlocked {
+				t.Errorf("got blocked %t, expected %t", got, testCase.ExpectBlocked)
+			}
+		})
+	}
+}
+
+// TestDataSourceReadOnlyExemptOperations lists the API operations called by data source reads which are blocked in read_only mode
+// unless exempted, so that a data source calling a new one is reviewed.
+func TestDataSourceReadOnlyExemptOperations(t *testing.T) {
+	expected := map[string][]string{
+		"apigatewayv2/export_data_source.go":        {"ExportApi"},
+		"ec2/ipam_preview_next_cidr_data_source.go": {"AllocateIpamPoolCidr"},
+		"glue/script_data_source.go":                {"CreateScript"},
+		"lambda/invocation_data_source.go":          {"Invoke"},
+	}
+
+	filenames, err := filepath.Glob(filepath.Join("..", "service", "*", "*_data_source.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	callRegexp := regexp.MustCompile(`\bconn\.([A-Z][0-9A-Za-z]*?)(?:WithContext|PagesWithContext|Pages|Request)?\(`)
+	got := make(map[string][]string)
+
+	for _, filename := range filenames {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		name := filepath.ToSlash(strings.TrimPrefix(filename, filepath.Join("..", "service")+string(filepath.Separator)))
+
+		for _, m := range callRegexp.FindAllStringSubmatch(string(b), -1) {
+			if operationName := m[1]; IsMutatingOperation(operationName) {
+				if !strings.Contains(string(b), fmt.Sprintf("WithReadOnlyExemptOperations(ctx, %q)", operationName)) {
+					t.Errorf("%s: %s is blocked in read_only mode and not exempted", name, operationName)
+				}
+
+				got[name] = append(got[name], operationName)
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
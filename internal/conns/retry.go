@@ -0,0 +1,80 @@
+package conns
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const retryableErrorsMiddlewareName = "terraform-provider-aws.RetryableErrors"
+
+// retryableErrorsHandler returns an AWS SDK for Go v1 request handler that retries the service's retryable errors.
+func retryableErrorsHandler(retryableErrors ...names.RetryableError) func(*request.Request) {
+	return func(r *request.Request) {
+		for _, e := range retryableErrors {
+			if e.MatchesOperation(r.Operation.Name) && tfawserr.ErrMessageContains(r.Error, e.Code, e.Message) {
+				r.Retryable = aws.Bool(true)
+
+				return
+			}
+		}
+	}
+}
+
+// retryableError marks an AWS SDK for Go v2 error as retryable to the SDK's retryer.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+func (e *retryableError) RetryableError() bool {
+	return true
+}
+
+// isRetryableAPIError returns whether the AWS SDK for Go v2 error returned by the operation is one of the retryable errors.
+func isRetryableAPIError(operationName string, err error, retryableErrors []names.RetryableError) bool {
+	var apiErr smithy.APIError
+
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	for _, e := range retryableErrors {
+		if e.MatchesOperation(operationName) && apiErr.ErrorCode() == e.Code && strings.Contains(apiErr.ErrorMessage(), e.Message) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryableErrorsMiddleware returns a function adding an AWS SDK for Go v2 middleware that retries the service's retryable errors.
+// The middleware runs for each attempt, inside the SDK's retry middleware.
+func retryableErrorsMiddleware(retryableErrors ...names.RetryableError) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Insert(middleware.FinalizeMiddlewareFunc(retryableErrorsMiddlewareName, func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+			out, metadata, err := next.HandleFinalize(ctx, in)
+
+			if err != nil && isRetryableAPIError(awsmiddleware.GetOperationName(ctx), err, retryableErrors) {
+				err = &retryableError{err: err}
+			}
+
+			return out, metadata, err
+		}), "Retry", middleware.After)
+	}
+}
@@ -0,0 +1,87 @@
+package conns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var testRetryableErrors = []names.RetryableError{
+	{Code: "ConflictException", Message: "try again later"},
+	{Operations: []string{"Describe*", "List*"}, Code: "FailedResourceAccessException"},
+}
+
+func TestRetryableErrorsHandler(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		OperationName string
+		Err           error
+		Expected      bool
+	}{
+		{Name: "no error", OperationName: "CreateRestApi", Expected: false},
+		{Name: "code and message", OperationName: "CreateRestApi", Err: awserr.New("ConflictException", "Please try again later.", nil), Expected: true},
+		{Name: "message mismatch", OperationName: "CreateRestApi", Err: awserr.New("ConflictException", "Resource already exists.", nil), Expected: false},
+		{Name: "operation prefix", OperationName: "DescribeScalingPolicies", Err: awserr.New("FailedResourceAccessException", "", nil), Expected: true},
+		{Name: "operation mismatch", OperationName: "PutScalingPolicy", Err: awserr.New("FailedResourceAccessException", "", nil), Expected: false},
+	}
+
+	handler := retryableErrorsHandler(testRetryableErrors...)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			r := &request.Request{
+				Operation: &request.Operation{Name: testCase.OperationName},
+				Error:     testCase.Err,
+			}
+
+			handler(r)
+
+			if got := r.Retryable != nil && *r.Retryable; got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIsRetryableAPIError(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		OperationName string
+		Err           error
+		Expected      bool
+	}{
+		{Name: "not API error", OperationName: "CreateIndex", Err: errors.New("ConflictException"), Expected: false},
+		{Name: "code and message", OperationName: "CreateIndex", Err: &smithy.GenericAPIError{Code: "ConflictException", Message: "Please try again later."}, Expected: true},
+		{Name: "code mismatch", OperationName: "CreateIndex", Err: &smithy.GenericAPIError{Code: "ValidationException", Message: "Please try again later."}, Expected: false},
+		{Name: "operation prefix", OperationName: "ListIndices", Err: &smithy.GenericAPIError{Code: "FailedResourceAccessException"}, Expected: true},
+		{Name: "operation mismatch", OperationName: "CreateIndex", Err: &smithy.GenericAPIError{Code: "FailedResourceAccessException"}, Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isRetryableAPIError(testCase.OperationName, testCase.Err, testRetryableErrors); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryableErrorIsRetryable(t *testing.T) {
+	err := &retryableError{err: &smithy.GenericAPIError{Code: "ConflictException"}}
+
+	if got := (retry.RetryableError{}).IsErrorRetryable(err); got != aws.TrueTernary {
+		t.Errorf("got %v, expected %v", got, aws.TrueTernary)
+	}
+
+	var apiErr smithy.APIError
+
+	if !errors.As(err, &apiErr) || apiErr.ErrorCode() != "ConflictException" {
+		t.Errorf("expected wrapped API error")
+	}
+}
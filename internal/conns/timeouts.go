@@ -0,0 +1,67 @@
+package conns
+
+import (
+	"path"
+	"time"
+)
+
+// Timeout keys, as used by the Terraform Plugin SDK, e.g. schema.TimeoutCreate.
+const (
+	TimeoutCreate = "create"
+	TimeoutDelete = "delete"
+	TimeoutRead   = "read"
+	TimeoutUpdate = "update"
+)
+
+// DefaultTimeoutsConfig are the timeouts to default across resources.
+type DefaultTimeoutsConfig struct {
+	ResourceTypeTimeouts []*ResourceTypeTimeouts
+}
+
+// ResourceTypeTimeouts are the timeouts to default across resources of matching types.
+type ResourceTypeTimeouts struct {
+	// ResourceTypes are path.Match patterns, e.g. "aws_db_*". Empty matches all resource types.
+	ResourceTypes []string
+	// Timeouts are keyed by operation, e.g. TimeoutCreate.
+	Timeouts map[string]time.Duration
+}
+
+func (rtt *ResourceTypeTimeouts) appliesTo(typeName string) bool {
+	if len(rtt.ResourceTypes) == 0 {
+		return true
+	}
+
+	for _, pattern := range rtt.ResourceTypes {
+		if matched, _ := path.Match(pattern, typeName); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForResourceType returns the default timeouts, keyed by operation, for resources of the given type.
+// Where multiple resource type timeouts match, later ones take precedence.
+func (c *DefaultTimeoutsConfig) ForResourceType(typeName string) map[string]time.Duration {
+	if c == nil {
+		return nil
+	}
+
+	var timeouts map[string]time.Duration
+
+	for _, rtt := range c.ResourceTypeTimeouts {
+		if !rtt.appliesTo(typeName) {
+			continue
+		}
+
+		for k, v := range rtt.Timeouts {
+			if timeouts == nil {
+				timeouts = make(map[string]time.Duration)
+			}
+
+			timeouts[k] = v
+		}
+	}
+
+	return timeouts
+}
@@ -0,0 +1,71 @@
+package conns
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDefaultTimeoutsConfigForResourceType(t *testing.T) {
+	config := &DefaultTimeoutsConfig{
+		ResourceTypeTimeouts: []*ResourceTypeTimeouts{
+			{
+				Timeouts: map[string]time.Duration{
+					TimeoutCreate: 90 * time.Minute,
+					TimeoutUpdate: 90 * time.Minute,
+				},
+			},
+			{
+				ResourceTypes: []string{"aws_db_*"},
+				Timeouts: map[string]time.Duration{
+					TimeoutCreate: 120 * time.Minute,
+					TimeoutDelete: 120 * time.Minute,
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		TypeName string
+		Config   *DefaultTimeoutsConfig
+		Expected map[string]time.Duration
+	}{
+		{
+			TypeName: "aws_db_instance",
+			Config:   config,
+			Expected: map[string]time.Duration{
+				TimeoutCreate: 120 * time.Minute,
+				TimeoutDelete: 120 * time.Minute,
+				TimeoutUpdate: 90 * time.Minute,
+			},
+		},
+		{
+			TypeName: "aws_eks_cluster",
+			Config:   config,
+			Expected: map[string]time.Duration{
+				TimeoutCreate: 90 * time.Minute,
+				TimeoutUpdate: 90 * time.Minute,
+			},
+		},
+		{
+			TypeName: "aws_vpc",
+			Config:   nil,
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TypeName, func(t *testing.T) {
+			got := testCase.Config.ForResourceType(testCase.TypeName)
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("got %v, expected %v", got, testCase.Expected)
+			}
+
+			for k, v := range testCase.Expected {
+				if got[k] != v {
+					t.Errorf("got %s timeout %s, expected %s", k, got[k], v)
+				}
+			}
+		})
+	}
+}
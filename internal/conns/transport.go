@@ -0,0 +1,70 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+)
+
+// HTTPTransportWrapper wraps the HTTP transport used for AWS API requests.
+type HTTPTransportWrapper func(http.RoundTripper) http.RoundTripper
+
+type httpTransportWrapperContextKey struct{}
+
+// NewHTTPTransportWrapperContext returns a copy of the context carrying an HTTP transport wrapper.
+// Clients configured with the context send all AWS API requests through the wrapped transport,
+// e.g. to record and replay requests during acceptance testing.
+func NewHTTPTransportWrapperContext(ctx context.Context, wrapper HTTPTransportWrapper) context.Context {
+	return context.WithValue(ctx, httpTransportWrapperContextKey{}, wrapper)
+}
+
+// httpTransportWrapperFromContext returns the HTTP transport wrapper carried by the context, if any.
+func httpTransportWrapperFromContext(ctx context.Context) (HTTPTransportWrapper, bool) {
+	wrapper, ok := ctx.Value(httpTransportWrapperContextKey{}).(HTTPTransportWrapper)
+
+	return wrapper, ok && wrapper != nil
+}
+
+// httpDoer is implemented by AWS SDK for Go v2 HTTP clients.
+type httpDoer interface {
+	Do(*http.Request) (*http.Response, error)
+}
+
+// doerTransport adapts an AWS SDK for Go v2 HTTP client to an http.RoundTripper.
+type doerTransport struct {
+	doer httpDoer
+}
+
+func (t doerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	return t.doer.Do(r)
+}
+
+// transportDoer adapts an http.RoundTripper to an AWS SDK for Go v2 HTTP client.
+type transportDoer struct {
+	transport http.RoundTripper
+}
+
+func (d transportDoer) Do(r *http.Request) (*http.Response, error) {
+	return d.transport.RoundTrip(r)
+}
+
+// wrapHTTPDoer returns an AWS SDK for Go v2 HTTP client sending requests through the wrapped transport.
+func wrapHTTPDoer(doer httpDoer, wrapper HTTPTransportWrapper) httpDoer {
+	return transportDoer{transport: wrapper(doerTransport{doer: doer})}
+}
+
+// wrapHTTPClient returns a copy of the AWS SDK for Go v1 HTTP client sending requests through the wrapped transport.
+func wrapHTTPClient(client *http.Client, wrapper HTTPTransportWrapper) *http.Client {
+	var c http.Client
+
+	if client != nil {
+		c = *client
+	}
+
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	c.Transport = wrapper(transport)
+
+	return &c
+}
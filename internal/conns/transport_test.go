@@ -0,0 +1,80 @@
+package conns
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestHTTPTransportWrapperContext(t *testing.T) {
+	if _, ok := httpTransportWrapperFromContext(context.Background()); ok {
+		t.Fatal("expected no wrapper in empty context")
+	}
+
+	var calls []string
+	wrapper := func(next http.RoundTripper) http.RoundTripper {
+		return roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+			calls = append(calls, "wrapper")
+			return next.RoundTrip(r)
+		})
+	}
+
+	got, ok := httpTransportWrapperFromContext(NewHTTPTransportWrapperContext(context.Background(), wrapper))
+	if !ok {
+		t.Fatal("expected wrapper in context")
+	}
+
+	inner := roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		calls = append(calls, "inner")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	})
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client := wrapHTTPClient(&http.Client{Transport: inner}, got)
+	if _, err := client.Do(req); err != nil {
+		t.Fatal(err)
+	}
+
+	doer := wrapHTTPDoer(&http.Client{Transport: inner}, got)
+	if _, err := doer.Do(req); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := strings.Join(calls, ","), "wrapper,inner,wrapper,inner"; got != want {
+		t.Errorf("got calls %q, want %q", got, want)
+	}
+}
+
+func TestWrapHTTPClientDefaultTransport(t *testing.T) {
+	var wrapped http.RoundTripper
+	wrapper := func(next http.RoundTripper) http.RoundTripper {
+		wrapped = next
+		return next
+	}
+
+	original := &http.Client{}
+	client := wrapHTTPClient(original, wrapper)
+
+	if wrapped != http.DefaultTransport {
+		t.Errorf("expected default transport to be wrapped, got %#v", wrapped)
+	}
+
+	if original.Transport != nil {
+		t.Error("expected original client to be unmodified")
+	}
+
+	if client == original {
+		t.Error("expected copy of client")
+	}
+}
@@ -0,0 +1,455 @@
+package flex
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type autoFlexOptions struct {
+	fieldNames        map[string]string
+	ignoredAttributes map[string]bool
+}
+
+// AutoFlexOptionsFunc configures Expand and Flatten.
+type AutoFlexOptionsFunc func(*autoFlexOptions)
+
+// WithFieldName maps the Terraform attribute name, at any level of nesting, to the specified struct field name.
+func WithFieldName(tfAttributeName, fieldName string) AutoFlexOptionsFunc {
+	return func(o *autoFlexOptions) {
+		o.fieldNames[tfAttributeName] = fieldName
+	}
+}
+
+// WithIgnoredAttribute excludes the Terraform attribute name, at any level of nesting, from mapping.
+func WithIgnoredAttribute(tfAttributeName string) AutoFlexOptionsFunc {
+	return func(o *autoFlexOptions) {
+		o.ignoredAttributes[tfAttributeName] = true
+	}
+}
+
+func newAutoFlexOptions(optFns []AutoFlexOptionsFunc) *autoFlexOptions {
+	o := &autoFlexOptions{
+		fieldNames:        make(map[string]string),
+		ignoredAttributes: make(map[string]bool),
+	}
+
+	for _, optFn := range optFns {
+		optFn(o)
+	}
+
+	return o
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Expand sets the fields of the AWS SDK struct pointed to by apiObject from the Terraform attribute values in tfMap,
+// e.g. the first element of a block's list. Fields without a corresponding attribute are not changed.
+// Empty string and zero number attribute values leave pointer fields nil.
+//
+// Attributes map to fields by name, e.g. the "kms_key_id" attribute to the KmsKeyId field, ignoring case and underscores.
+// Supported field types are pointer and non-pointer scalars (including string enumerations), time.Time,
+// nested structs, and slices and maps of these. Nested structs map to blocks, i.e. lists or sets of maps.
+func Expand(tfMap map[string]interface{}, apiObject interface{}, optFns ...AutoFlexOptionsFunc) error {
+	v := reflect.ValueOf(apiObject)
+
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("expected pointer to struct, got %T", apiObject)
+	}
+
+	return newAutoFlexOptions(optFns).expandStruct(tfMap, v.Elem())
+}
+
+func (o *autoFlexOptions) expandStruct(tfMap map[string]interface{}, to reflect.Value) error {
+	for tfAttributeName, tfValue := range tfMap {
+		if o.ignoredAttributes[tfAttributeName] {
+			continue
+		}
+
+		field, ok := o.field(to, tfAttributeName)
+
+		if !ok {
+			continue
+		}
+
+		if err := o.expandValue(tfValue, field); err != nil {
+			return fmt.Errorf("expanding %s: %w", tfAttributeName, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *autoFlexOptions) expandValue(tfValue interface{}, to reflect.Value) error {
+	if tfValue == nil {
+		return nil
+	}
+
+	switch to.Kind() {
+	case reflect.Ptr:
+		if isEmptyScalar(tfValue) || isEmptyList(tfValue) {
+			return nil
+		}
+
+		v := reflect.New(to.Type().Elem())
+
+		if err := o.expandValue(tfValue, v.Elem()); err != nil {
+			return err
+		}
+
+		to.Set(v)
+
+		return nil
+
+	case reflect.Struct:
+		if to.Type() == timeType {
+			s, ok := tfValue.(string)
+
+			if !ok {
+				return fmt.Errorf("cannot expand %T into %s", tfValue, to.Type())
+			}
+
+			t, err := time.Parse(time.RFC3339, s)
+
+			if err != nil {
+				return err
+			}
+
+			to.Set(reflect.ValueOf(t))
+
+			return nil
+		}
+
+		// Elements of a block's list are maps, the block itself is a list with a single element.
+		tfMap, ok := tfValue.(map[string]interface{})
+
+		if !ok {
+			items := listItems(tfValue)
+
+			if len(items) == 0 {
+				return nil
+			}
+
+			tfMap, _ = items[0].(map[string]interface{})
+		}
+
+		return o.expandStruct(tfMap, to)
+
+	case reflect.Slice:
+		items := listItems(tfValue)
+
+		if items == nil {
+			return fmt.Errorf("cannot expand %T into %s", tfValue, to.Type())
+		}
+
+		v := reflect.MakeSlice(to.Type(), 0, len(items))
+
+		for _, item := range items {
+			// As ExpandStringList, skip empty elements of slices of pointers, e.g. []*string.
+			if to.Type().Elem().Kind() == reflect.Ptr && isEmptyScalar(item) {
+				continue
+			}
+
+			elem := reflect.New(to.Type().Elem()).Elem()
+
+			if err := o.expandValue(item, elem); err != nil {
+				return err
+			}
+
+			v = reflect.Append(v, elem)
+		}
+
+		to.Set(v)
+
+		return nil
+
+	case reflect.Map:
+		tfMap, ok := tfValue.(map[string]interface{})
+
+		if !ok || to.Type().Key().Kind() != reflect.String {
+			return fmt.Errorf("cannot expand %T into %s", tfValue, to.Type())
+		}
+
+		v := reflect.MakeMapWithSize(to.Type(), len(tfMap))
+
+		for k, item := range tfMap {
+			elem := reflect.New(to.Type().Elem()).Elem()
+
+			if err := o.expandValue(item, elem); err != nil {
+				return err
+			}
+
+			v.SetMapIndex(reflect.ValueOf(k).Convert(to.Type().Key()), elem)
+		}
+
+		to.Set(v)
+
+		return nil
+	}
+
+	v := reflect.ValueOf(tfValue)
+
+	switch {
+	case to.Kind() == reflect.String && v.Kind() == reflect.String,
+		to.Kind() == reflect.Bool && v.Kind() == reflect.Bool,
+		isNumber(to.Kind()) && isNumber(v.Kind()):
+		to.Set(v.Convert(to.Type()))
+
+		return nil
+	}
+
+	return fmt.Errorf("cannot expand %T into %s", tfValue, to.Type())
+}
+
+// Flatten returns the Terraform attribute values, for the attributes of the specified schema,
+// of the AWS SDK struct or pointer to struct apiObject. Attributes without a corresponding field are omitted.
+// Attributes map to fields as with Expand.
+// The result is suitable for setting each top-level attribute in ResourceData, or as an element of a block's list.
+func Flatten(apiObject interface{}, schemaMap map[string]*schema.Schema, optFns ...AutoFlexOptionsFunc) (map[string]interface{}, error) {
+	v := reflect.Indirect(reflect.ValueOf(apiObject))
+
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected struct or pointer to struct, got %T", apiObject)
+	}
+
+	return newAutoFlexOptions(optFns).flattenStruct(v, schemaMap)
+}
+
+func (o *autoFlexOptions) flattenStruct(from reflect.Value, schemaMap map[string]*schema.Schema) (map[string]interface{}, error) {
+	tfMap := make(map[string]interface{})
+
+	for tfAttributeName, s := range schemaMap {
+		if o.ignoredAttributes[tfAttributeName] {
+			continue
+		}
+
+		field, ok := o.field(from, tfAttributeName)
+
+		if !ok {
+			continue
+		}
+
+		tfValue, err := o.flattenValue(field, s)
+
+		if err != nil {
+			return nil, fmt.Errorf("flattening %s: %w", tfAttributeName, err)
+		}
+
+		tfMap[tfAttributeName] = tfValue
+	}
+
+	return tfMap, nil
+}
+
+func (o *autoFlexOptions) flattenValue(from reflect.Value, s *schema.Schema) (interface{}, error) {
+	if from.Kind() == reflect.Ptr || from.Kind() == reflect.Interface {
+		if from.IsNil() {
+			return zeroValue(s), nil
+		}
+
+		return o.flattenValue(from.Elem(), s)
+	}
+
+	switch s.Type {
+	case schema.TypeString:
+		switch {
+		case from.Kind() == reflect.String:
+			return from.String(), nil
+		case from.Type() == timeType:
+			t := from.Interface().(time.Time)
+
+			if t.IsZero() {
+				return "", nil
+			}
+
+			return t.Format(time.RFC3339), nil
+		}
+
+	case schema.TypeBool:
+		if from.Kind() == reflect.Bool {
+			return from.Bool(), nil
+		}
+
+	case schema.TypeInt:
+		if isNumber(from.Kind()) {
+			return int(from.Convert(reflect.TypeOf(int64(0))).Int()), nil
+		}
+
+	case schema.TypeFloat:
+		if isNumber(from.Kind()) {
+			return from.Convert(reflect.TypeOf(float64(0))).Float(), nil
+		}
+
+	case schema.TypeList, schema.TypeSet:
+		switch elem := s.Elem.(type) {
+		case *schema.Resource:
+			switch from.Kind() {
+			case reflect.Struct:
+				tfMap, err := o.flattenStruct(from, elem.Schema)
+
+				if err != nil {
+					return nil, err
+				}
+
+				return []interface{}{tfMap}, nil
+
+			case reflect.Slice:
+				tfList := make([]interface{}, 0, from.Len())
+
+				for i := 0; i < from.Len(); i++ {
+					item := reflect.Indirect(from.Index(i))
+
+					if !item.IsValid() {
+						continue
+					}
+
+					tfMap, err := o.flattenStruct(item, elem.Schema)
+
+					if err != nil {
+						return nil, err
+					}
+
+					tfList = append(tfList, tfMap)
+				}
+
+				return tfList, nil
+			}
+
+		case *schema.Schema:
+			if from.Kind() == reflect.Slice {
+				tfList := make([]interface{}, 0, from.Len())
+
+				for i := 0; i < from.Len(); i++ {
+					item := from.Index(i)
+
+					// Skip nil elements, e.g. of []*string.
+					if item.Kind() == reflect.Ptr && item.IsNil() {
+						continue
+					}
+
+					tfValue, err := o.flattenValue(item, elem)
+
+					if err != nil {
+						return nil, err
+					}
+
+					tfList = append(tfList, tfValue)
+				}
+
+				return tfList, nil
+			}
+		}
+
+	case schema.TypeMap:
+		if from.Kind() == reflect.Map && from.Type().Key().Kind() == reflect.String {
+			elem, ok := s.Elem.(*schema.Schema)
+
+			if !ok {
+				elem = &schema.Schema{Type: schema.TypeString}
+			}
+
+			tfMap := make(map[string]interface{}, from.Len())
+			iter := from.MapRange()
+
+			for iter.Next() {
+				tfValue, err := o.flattenValue(iter.Value(), elem)
+
+				if err != nil {
+					return nil, err
+				}
+
+				tfMap[iter.Key().String()] = tfValue
+			}
+
+			return tfMap, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot flatten %s into %s", from.Type(), s.Type)
+}
+
+// field returns the struct field corresponding to the Terraform attribute name.
+func (o *autoFlexOptions) field(v reflect.Value, tfAttributeName string) (reflect.Value, bool) {
+	if fieldName, ok := o.fieldNames[tfAttributeName]; ok {
+		if f, ok := v.Type().FieldByName(fieldName); ok && f.PkgPath == "" {
+			return v.FieldByIndex(f.Index), true
+		}
+
+		return reflect.Value{}, false
+	}
+
+	name := normalizeFieldName(tfAttributeName)
+
+	for i, t := 0, v.Type(); i < t.NumField(); i++ {
+		if f := t.Field(i); f.PkgPath == "" && normalizeFieldName(f.Name) == name {
+			return v.Field(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+func normalizeFieldName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// listItems returns the elements of a Terraform list or set value, or nil if the value is neither.
+func listItems(tfValue interface{}) []interface{} {
+	switch v := tfValue.(type) {
+	case []interface{}:
+		return v
+	case *schema.Set:
+		return v.List()
+	}
+
+	return nil
+}
+
+func isEmptyScalar(tfValue interface{}) bool {
+	switch v := tfValue.(type) {
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	}
+
+	return false
+}
+
+func isEmptyList(tfValue interface{}) bool {
+	items := listItems(tfValue)
+
+	return items != nil && len(items) == 0
+}
+
+func isNumber(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+
+	return false
+}
+
+// zeroValue returns the Terraform attribute value for a nil field.
+func zeroValue(s *schema.Schema) interface{} {
+	switch s.Type {
+	case schema.TypeString:
+		return ""
+	case schema.TypeBool:
+		return false
+	case schema.TypeInt:
+		return 0
+	case schema.TypeFloat:
+		return 0.0
+	}
+
+	return nil
+}
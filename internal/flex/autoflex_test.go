@@ -0,0 +1,226 @@
+package flex
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type testAutoFlexEnum string
+
+type testAutoFlexNested struct {
+	_ struct{} `type:"structure"`
+
+	Name    *string
+	Port    *int64
+	Enabled *bool
+}
+
+type testAutoFlexObject struct {
+	_ struct{} `type:"structure"`
+
+	Arn           *string
+	CreatedAt     *time.Time
+	Description   *string
+	KmsKeyId      *string
+	MaxCount      *int64
+	Ratio         *float64
+	Mode          testAutoFlexEnum
+	SecurityGroup []*string
+	Tags          map[string]*string
+	Config        *testAutoFlexNested
+	Listeners     []*testAutoFlexNested
+}
+
+var testAutoFlexSchema = map[string]*schema.Schema{
+	"arn":         {Type: schema.TypeString},
+	"created_at":  {Type: schema.TypeString},
+	"description": {Type: schema.TypeString},
+	"kms_key_id":  {Type: schema.TypeString},
+	"max_count":   {Type: schema.TypeInt},
+	"ratio":       {Type: schema.TypeFloat},
+	"mode":        {Type: schema.TypeString},
+	"security_group_ids": {
+		Type: schema.TypeSet,
+		Elem: &schema.Schema{Type: schema.TypeString},
+	},
+	"tags": {
+		Type: schema.TypeMap,
+		Elem: &schema.Schema{Type: schema.TypeString},
+	},
+	"config": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Elem:     testAutoFlexNestedResource,
+	},
+	"listener": {
+		Type: schema.TypeList,
+		Elem: testAutoFlexNestedResource,
+	},
+}
+
+var testAutoFlexNestedResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name":    {Type: schema.TypeString},
+		"port":    {Type: schema.TypeInt},
+		"enabled": {Type: schema.TypeBool},
+	},
+}
+
+var testAutoFlexOptions = []AutoFlexOptionsFunc{
+	WithFieldName("security_group_ids", "SecurityGroup"),
+	WithFieldName("listener", "Listeners"),
+	WithIgnoredAttribute("arn"),
+}
+
+func TestExpand(t *testing.T) {
+	created := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	tfMap := map[string]interface{}{
+		"arn":                "arn:aws:example:::ignored", //lintignore:AWSAT005
+		"created_at":         created.Format(time.RFC3339),
+		"description":        "",
+		"kms_key_id":         "key-1",
+		"max_count":          3,
+		"ratio":              0.5,
+		"mode":               "ACTIVE",
+		"security_group_ids": schema.NewSet(schema.HashString, []interface{}{"sg-1"}),
+		"tags":               map[string]interface{}{"Name": "test"},
+		"config": []interface{}{
+			map[string]interface{}{
+				"name":    "primary",
+				"port":    0,
+				"enabled": false,
+			},
+		},
+		"listener": []interface{}{
+			map[string]interface{}{"name": "http", "port": 80, "enabled": true},
+			map[string]interface{}{"name": "https", "port": 443, "enabled": true},
+		},
+		"not_a_field": "ignored",
+	}
+
+	got := &testAutoFlexObject{}
+
+	if err := Expand(tfMap, got, testAutoFlexOptions...); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &testAutoFlexObject{
+		CreatedAt:     aws.Time(created),
+		KmsKeyId:      aws.String("key-1"),
+		MaxCount:      aws.Int64(3),
+		Ratio:         aws.Float64(0.5),
+		Mode:          testAutoFlexEnum("ACTIVE"),
+		SecurityGroup: aws.StringSlice([]string{"sg-1"}),
+		Tags:          aws.StringMap(map[string]string{"Name": "test"}),
+		Config: &testAutoFlexNested{
+			Name:    aws.String("primary"),
+			Enabled: aws.Bool(false),
+		},
+		Listeners: []*testAutoFlexNested{
+			{Name: aws.String("http"), Port: aws.Int64(80), Enabled: aws.Bool(true)},
+			{Name: aws.String("https"), Port: aws.Int64(443), Enabled: aws.Bool(true)},
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
+
+func TestExpand_errors(t *testing.T) {
+	if err := Expand(map[string]interface{}{}, testAutoFlexObject{}); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+
+	if err := Expand(map[string]interface{}{"max_count": "three"}, &testAutoFlexObject{}); err == nil {
+		t.Error("expected error for mismatched type")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	created := time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	apiObject := &testAutoFlexObject{
+		Arn:           aws.String("arn:aws:example:::ignored"), //lintignore:AWSAT005
+		CreatedAt:     aws.Time(created),
+		KmsKeyId:      aws.String("key-1"),
+		MaxCount:      aws.Int64(3),
+		Mode:          testAutoFlexEnum("ACTIVE"),
+		SecurityGroup: []*string{aws.String("sg-1"), nil},
+		Tags:          aws.StringMap(map[string]string{"Name": "test"}),
+		Listeners: []*testAutoFlexNested{
+			{Name: aws.String("http"), Port: aws.Int64(80)},
+			nil,
+		},
+	}
+
+	got, err := Flatten(apiObject, testAutoFlexSchema, testAutoFlexOptions...)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"created_at":         created.Format(time.RFC3339),
+		"description":        "",
+		"kms_key_id":         "key-1",
+		"max_count":          3,
+		"ratio":              0.0,
+		"mode":               "ACTIVE",
+		"security_group_ids": []interface{}{"sg-1"},
+		"tags":               map[string]interface{}{"Name": "test"},
+		"config":             nil,
+		"listener": []interface{}{
+			map[string]interface{}{"name": "http", "port": 80, "enabled": false},
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
+
+func TestFlatten_value(t *testing.T) {
+	apiObject := testAutoFlexObject{
+		KmsKeyId:      aws.String("key-1"),
+		SecurityGroup: aws.StringSlice([]string{"sg-1"}),
+	}
+	schemaMap := map[string]*schema.Schema{
+		"kms_key_id":         testAutoFlexSchema["kms_key_id"],
+		"security_group_ids": testAutoFlexSchema["security_group_ids"],
+	}
+
+	got, err := Flatten(apiObject, schemaMap, testAutoFlexOptions...)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]interface{}{
+		"kms_key_id":         "key-1",
+		"security_group_ids": []interface{}{"sg-1"},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", got, expected)
+	}
+}
+
+func TestFlatten_errors(t *testing.T) {
+	schemaMap := map[string]*schema.Schema{
+		"max_count": {Type: schema.TypeBool},
+	}
+
+	if _, err := Flatten(&testAutoFlexObject{MaxCount: aws.Int64(1)}, schemaMap); err == nil {
+		t.Error("expected error for mismatched type")
+	}
+
+	if _, err := Flatten("not a struct", schemaMap); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
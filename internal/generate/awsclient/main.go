@@ -131,24 +131,30 @@ import (
 {{ range .Services }}
 	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
 {{- end }}
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 type AWSClient struct {
 	AccountID                 string
+	AssumedRoleARNs           []string
 	DefaultTagsConfig         *tftags.DefaultConfig
+	DefaultTimeoutsConfig     *DefaultTimeoutsConfig
 	DNSSuffix                 string
 	IgnoreTagsConfig          *tftags.IgnoreConfig
 	MediaConvertAccountConn   *mediaconvert.MediaConvert
 	Partition                 string
 	Region                    string
+	RequiredTagsConfig        *tftags.RequiredConfig
 	ReverseDNSPrefix          string
 	S3ConnURICleaningDisabled *s3.S3
 	Session                   *session.Session
 	SupportedPlatforms        []string
 	TerraformVersion          string
 
+	resourceTypeClients *resourceTypeClients
+
 	{{ range .Services }}
 	{{ .ProviderNameUpper }}Conn *{{ .GoPackage }}.{{ .ClientName }}
 	{{- end }}
@@ -167,4 +173,22 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// addServiceClientHandler replaces the AWS SDK for Go v1 service clients with copies
+// which run the handler first when validating each request.
+func (client *AWSClient) addServiceClientHandler(handler request.NamedHandler) {
+	if client.MediaConvertAccountConn != nil {
+		client.MediaConvertAccountConn = &mediaconvert.MediaConvert{Client: withValidateHandler(client.MediaConvertAccountConn.Client, handler)}
+	}
+	if client.S3ConnURICleaningDisabled != nil {
+		client.S3ConnURICleaningDisabled = &s3.S3{Client: withValidateHandler(client.S3ConnURICleaningDisabled.Client, handler)}
+	}
+{{- range .Services }}
+{{- if eq .SDKVersion "1" }}
+	if client.{{ .ProviderNameUpper }}Conn != nil {
+		client.{{ .ProviderNameUpper }}Conn = &{{ .GoPackage }}.{{ .ClientName }}{Client: withValidateHandler(client.{{ .ProviderNameUpper }}Conn.Client, handler)}
+	}
+{{- end }}
+{{- end }}
+}
 `
@@ -164,6 +164,18 @@ func main() {
 			log.Fatalf("in names_data.csv, for service %s, AllowedSubcategory can only be non-blank if Exclude is non-blank", l[names.ColHumanFriendly])
 		}
 
+		if _, err := names.ParseRetryableErrors(l[names.ColRetryableErrors]); err != nil {
+			log.Fatalf("in names_data.csv, for service %s, RetryableErrors is invalid: %s", l[names.ColHumanFriendly], err)
+		}
+
+		if l[names.ColRetryableErrors] != "" && l[names.ColExclude] != "" {
+			log.Fatalf("in names_data.csv, for service %s, RetryableErrors must be blank if Exclude is non-blank", l[names.ColHumanFriendly])
+		}
+
+		if l[names.ColGoV1Package] != "" && l[names.ColEndpointsID] == "" {
+			log.Fatalf("in names_data.csv, for service %s, EndpointsID cannot be blank if GoV1Package is non-blank", l[names.ColHumanFriendly])
+		}
+
 		if l[names.ColExclude] != "" && l[names.ColNote] == "" {
 			log.Fatalf("in names_data.csv, for service %s, if Exclude is not blank, include a Note why", l[names.ColHumanFriendly])
 		}
@@ -11,6 +11,7 @@ import (
 	"log"
 	"os"
 	"sort"
+	"strings"
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -25,10 +26,12 @@ type ServiceDatum struct {
 	SDKVersion        string
 	GoPackage         string
 	ProviderNameUpper string
+	RetryableErrors   []string
 }
 
 type TemplateData struct {
-	Services []ServiceDatum
+	Services          []ServiceDatum
+	RetryableServices []ServiceDatum
 }
 
 func main() {
@@ -55,7 +58,7 @@ func main() {
 			continue
 		}
 
-		if l[names.ColExclude] != "" || l[names.ColSkipClientGenerate] != "" {
+		if l[names.ColExclude] != "" {
 			continue
 		}
 
@@ -74,6 +77,28 @@ func main() {
 			s.GoPackage = l[names.ColGoV2Package]
 		}
 
+		retryableErrors, err := names.ParseRetryableErrors(l[names.ColRetryableErrors])
+		if err != nil {
+			log.Fatalf("in names_data.csv, for service %s, %s", l[names.ColHumanFriendly], err)
+		}
+
+		for _, e := range retryableErrors {
+			s.RetryableErrors = append(s.RetryableErrors, retryableErrorLiteral(e))
+		}
+
+		// AWS SDK for Go v1 clients configured in internal/conns/config.go still get their retry handlers.
+		if len(s.RetryableErrors) > 0 && s.SDKVersion == "1" {
+			td.RetryableServices = append(td.RetryableServices, s)
+		}
+
+		if l[names.ColSkipClientGenerate] != "" {
+			if len(s.RetryableErrors) > 0 && s.SDKVersion == "2" {
+				log.Fatalf("in names_data.csv, for service %s, RetryableErrors must be configured in internal/conns/config.go if SkipClientGenerate is not blank", l[names.ColHumanFriendly])
+			}
+
+			continue
+		}
+
 		td.Services = append(td.Services, s)
 	}
 
@@ -81,9 +106,36 @@ func main() {
 		return td.Services[i].ProviderNameUpper < td.Services[j].ProviderNameUpper
 	})
 
+	sort.SliceStable(td.RetryableServices, func(i, j int) bool {
+		return td.RetryableServices[i].ProviderNameUpper < td.RetryableServices[j].ProviderNameUpper
+	})
+
 	writeTemplate(tmpl, "awsclient", td)
 }
 
+// retryableErrorLiteral returns the Go composite literal for the retryable error.
+func retryableErrorLiteral(e names.RetryableError) string {
+	var fields []string
+
+	if len(e.Operations) > 0 {
+		operations := make([]string, len(e.Operations))
+
+		for i, v := range e.Operations {
+			operations[i] = fmt.Sprintf("%q", v)
+		}
+
+		fields = append(fields, fmt.Sprintf("Operations: []string{%s}", strings.Join(operations, ", ")))
+	}
+
+	fields = append(fields, fmt.Sprintf("Code: %q", e.Code))
+
+	if e.Message != "" {
+		fields = append(fields, fmt.Sprintf("Message: %q", e.Message))
+	}
+
+	return fmt.Sprintf("names.RetryableError{%s}", strings.Join(fields, ", "))
+}
+
 func writeTemplate(body string, templateName string, td TemplateData) {
 	// If the file doesn't exist, create it, or append to the file
 	f, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
@@ -122,7 +174,7 @@ var tmpl = `
 package conns
 
 import (
-	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 {{- range .Services }}
 	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
@@ -130,11 +182,40 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func (c *Config) clientConns(sess *session.Session) *AWSClient {
+func (c *Config) clientConns(sess *session.Session, cfg aws.Config) *AWSClient {
 	return &AWSClient{
 		{{- range .Services }}
-		{{ .ProviderNameUpper }}Conn: {{ .GoPackage }}.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[names.{{ .ProviderNameUpper }}])})),
+		{{- if eq .SDKVersion "2" }}
+		{{ .ProviderNameUpper }}Conn: {{ .GoPackage }}.NewFromConfig(cfg, func(o *{{ .GoPackage }}.Options) {
+			if endpoint := c.Endpoints[names.{{ .ProviderNameUpper }}]; endpoint != "" {
+				o.EndpointResolver = {{ .GoPackage }}.EndpointResolverFromURL(endpoint)
+			}
+			if l, ok := c.rateLimiters[names.{{ .ProviderNameUpper }}]; ok {
+				o.APIOptions = append(o.APIOptions, l.addMiddleware)
+			}
+			{{- if .RetryableErrors }}
+			o.APIOptions = append(o.APIOptions, retryableErrorsMiddleware(
+				{{- range .RetryableErrors }}
+				{{ . }},
+				{{- end }}
+			))
+			{{- end }}
+		}),
+		{{- else }}
+		{{ .ProviderNameUpper }}Conn: {{ .GoPackage }}.New(c.sessionForService(sess, names.{{ .ProviderNameUpper }})),
+		{{- end }}
 		{{- end }}
 	}
 }
+
+// addRetryableErrorsHandlers adds the retryable errors declared in names_data.csv to AWS SDK for Go v1 clients.
+func (c *Config) addRetryableErrorsHandlers(client *AWSClient) {
+	{{- range .RetryableServices }}
+	client.{{ .ProviderNameUpper }}Conn.Handlers.Retry.PushBack(retryableErrorsHandler(
+		{{- range .RetryableErrors }}
+		{{ . }},
+		{{- end }}
+	))
+	{{- end }}
+}
 `
@@ -207,6 +207,12 @@ func Provider() *schema.Provider {
 				ConflictsWith: []string{"forbidden_account_ids"},
 				Set:           schema.HashString,
 			},
+			"api_call_metrics_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to a local file to which per-API-call metrics are written as JSON lines, " +
+					"followed by a per-operation summary when the provider exits.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -223,6 +229,29 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"resource_type_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Configuration blocks with resource tags to default across resources of matching types.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_types": {
+										Type:        schema.TypeSet,
+										Required:    true,
+										MinItems:    1,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Resource type patterns, e.g. aws_ebs_*, to which the resource tags apply.",
+									},
+									"tags": {
+										Type:        schema.TypeMap,
+										Required:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Resource tags to default across resources of matching types. Override any matching resource tags to default across all resources.",
+									},
+								},
+							},
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -232,6 +261,46 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"default_timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with timeouts to default across resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default create timeout, e.g. 60m.",
+						},
+						"delete": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default delete timeout, e.g. 60m.",
+						},
+						"read": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default read timeout, e.g. 60m.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource type patterns, e.g. aws_db_*, to which the timeouts apply. Defaults to all resource types.",
+						},
+						"update": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "Default update timeout, e.g. 60m.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -279,6 +348,13 @@ func Provider() *schema.Provider {
 							Set:         schema.HashString,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsValidRegExp},
+							Set:         schema.HashString,
+							Description: "Resource tag key regular expressions to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -304,12 +380,81 @@ func Provider() *schema.Provider {
 				Description: "The profile for API operations. If not set, the default profile\n" +
 					"created with `aws configure` will be used.",
 			},
+			"rate_limits": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with client-side request rate limits for individual services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of requests that can be made at once. Defaults to requests_per_second rounded up.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0.01),
+							Description:  "The sustained number of requests per second.",
+						},
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validServiceAlias,
+							Description:  "The service to limit, using the same names as the endpoints block.",
+						},
+					},
+				},
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Reject AWS API operations that would modify resources. " +
+					"Used as a safety net for plan-only runs.",
+			},
 			"region": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"required_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks with tags that must be present on taggable resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values_regex": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+							Description:  "Regular expression that the tag value must match.",
+						},
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource type patterns, e.g. aws_ebs_*, to which the tag requirement does not apply.",
+						},
+						"key": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 128),
+							Description:  "Resource tag key that must be present.",
+						},
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Resource type patterns, e.g. aws_ebs_*, to which the tag requirement applies. Defaults to all resource types.",
+						},
+					},
+				},
+			},
 			"s3_force_path_style": {
 				Type:       schema.TypeBool,
 				Optional:   true,
@@ -458,7 +603,8 @@ func Provider() *schema.Provider {
 			"aws_ce_cost_category": ce.DataSourceCostCategory(),
 			"aws_ce_tags":          ce.DataSourceTags(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":  cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resources": cloudcontrol.DataSourceResources(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -2067,6 +2213,10 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	for typeName, r := range provider.ResourcesMap {
+		wrapResourceForType(typeName, r)
+	}
+
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -2080,10 +2230,84 @@ func Provider() *schema.Provider {
 	return provider
 }
 
+// wrapResourceForType wraps the functions of the resource of the given type so that
+// they are passed the provider client for the resource type (see conns.AWSClient.ForResourceType)
+// and the resource type name is carried by the context (see conns.NewResourceTypeNameContext),
+// making it available to shared CustomizeDiff functions, e.g. verify.SetTagsDiff, and to API call metrics.
+func wrapResourceForType(typeName string, r *schema.Resource) {
+	wrapMeta := func(meta interface{}) interface{} {
+		if client, ok := meta.(*conns.AWSClient); ok {
+			return client.ForResourceType(typeName)
+		}
+
+		return meta
+	}
+
+	wrapFunc := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, wrapMeta(meta))
+		}
+	}
+
+	r.Create = wrapFunc(r.Create)
+	r.Read = wrapFunc(r.Read)
+	r.Update = wrapFunc(r.Update)
+	r.Delete = wrapFunc(r.Delete)
+
+	wrapContextFunc := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(conns.NewResourceTypeNameContext(ctx, typeName), d, wrapMeta(meta))
+		}
+	}
+
+	r.CreateContext = wrapContextFunc(r.CreateContext)
+	r.ReadContext = wrapContextFunc(r.ReadContext)
+	r.UpdateContext = wrapContextFunc(r.UpdateContext)
+	r.DeleteContext = wrapContextFunc(r.DeleteContext)
+	r.CreateWithoutTimeout = wrapContextFunc(r.CreateWithoutTimeout)
+	r.ReadWithoutTimeout = wrapContextFunc(r.ReadWithoutTimeout)
+	r.UpdateWithoutTimeout = wrapContextFunc(r.UpdateWithoutTimeout)
+	r.DeleteWithoutTimeout = wrapContextFunc(r.DeleteWithoutTimeout)
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return f(conns.NewResourceTypeNameContext(ctx, typeName), diff, wrapMeta(meta))
+		}
+	}
+
+	if r.Importer != nil {
+		// Importers may be shared between resources.
+		importer := *r.Importer
+
+		if f := importer.State; f != nil {
+			importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return f(d, wrapMeta(meta))
+			}
+		}
+		if f := importer.StateContext; f != nil {
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return f(conns.NewResourceTypeNameContext(ctx, typeName), d, wrapMeta(meta))
+			}
+		}
+
+		r.Importer = &importer
+	}
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APICallMetricsFile:             d.Get("api_call_metrics_file").(string),
 		DefaultTagsConfig:              expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
+		DefaultTimeoutsConfig:          expandProviderDefaultTimeouts(d.Get("default_timeouts").([]interface{})),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -2093,7 +2317,9 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     d.Get("max_retries").(int),
 		Profile:                        d.Get("profile").(string),
+		ReadOnly:                       d.Get("read_only").(bool),
 		Region:                         d.Get("region").(string),
+		RequiredTagsConfig:             expandProviderRequiredTags(d.Get("required_tags").([]interface{})),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool) || d.Get("s3_force_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
@@ -2126,9 +2352,23 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		config.SharedCredentialsFiles = l
 	}
 
-	if l, ok := d.Get("assume_role").([]interface{}); ok && len(l) > 0 && l[0] != nil {
-		config.AssumeRole = expandAssumeRole(l[0].(map[string]interface{}))
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q)", config.AssumeRole.RoleARN, config.AssumeRole.SessionName, config.AssumeRole.ExternalID)
+	if l, ok := d.Get("assume_role").([]interface{}); ok {
+		for i, v := range l {
+			if v == nil {
+				continue
+			}
+
+			tfMap := v.(map[string]interface{})
+
+			// ConflictsWith is only supported for the first of multiple configuration blocks.
+			if tfMap["duration"].(string) != "" && tfMap["duration_seconds"].(int) != 0 {
+				return nil, diag.Errorf("assume_role.%d: only one of duration, duration_seconds can be specified", i)
+			}
+
+			assumeRole := expandAssumeRole(tfMap)
+			log.Printf("[INFO] assume_role configuration set: (Hop: %d, ARN: %q, SessionID: %q, ExternalID: %q)", i+1, assumeRole.RoleARN, assumeRole.SessionName, assumeRole.ExternalID)
+			config.AssumeRoles = append(config.AssumeRoles, assumeRole)
+		}
 	}
 
 	if l, ok := d.Get("assume_role_with_web_identity").([]interface{}); ok && len(l) > 0 && l[0] != nil {
@@ -2136,6 +2376,12 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q)", config.AssumeRoleWithWebIdentity.RoleARN, config.AssumeRoleWithWebIdentity.SessionName)
 	}
 
+	rateLimits, err := expandRateLimits(d.Get("rate_limits").([]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RateLimits = rateLimits
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 	if err := expandEndpoints(endpointsSet.List(), config.Endpoints); err != nil {
 		return nil, diag.FromErr(err)
@@ -2166,25 +2412,23 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeList,
-		Optional: true,
-		MaxItems: 1,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "IAM Roles to assume prior to making API calls. Multiple roles are assumed in order, each using the credentials of the previous role.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"duration": {
-					Type:          schema.TypeString,
-					Optional:      true,
-					Description:   "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
-					ValidateFunc:  validAssumeRoleDuration,
-					ConflictsWith: []string{"assume_role.0.duration_seconds"},
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m. Conflicts with duration_seconds.",
+					ValidateFunc: validAssumeRoleDuration,
 				},
 				"duration_seconds": {
-					Type:          schema.TypeInt,
-					Optional:      true,
-					Deprecated:    "Use assume_role.duration instead",
-					Description:   "The duration, in seconds, of the role session.",
-					ValidateFunc:  validation.IntBetween(900, 43200),
-					ConflictsWith: []string{"assume_role.0.duration"},
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Deprecated:   "Use assume_role.duration instead",
+					Description:  "The duration, in seconds, of the role session. Conflicts with duration.",
+					ValidateFunc: validation.IntBetween(900, 43200),
 				},
 				"external_id": {
 					Type:        schema.TypeString,
@@ -2443,6 +2687,68 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
+
+	if v, ok := m["resource_type_tags"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			resourceTypeConfig := &tftags.ResourceTypeDefaultConfig{}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+				for _, v := range v.List() {
+					resourceTypeConfig.ResourceTypes = append(resourceTypeConfig.ResourceTypes, v.(string))
+				}
+			}
+
+			if v, ok := tfMap["tags"].(map[string]interface{}); ok {
+				resourceTypeConfig.Tags = tftags.New(v)
+			}
+
+			defaultConfig.ResourceTypeTags = append(defaultConfig.ResourceTypeTags, resourceTypeConfig)
+		}
+	}
+
+	return defaultConfig
+}
+
+func expandProviderDefaultTimeouts(l []interface{}) *conns.DefaultTimeoutsConfig {
+	if len(l) == 0 {
+		return nil
+	}
+
+	defaultConfig := &conns.DefaultTimeoutsConfig{}
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		rtt := &conns.ResourceTypeTimeouts{
+			Timeouts: make(map[string]time.Duration),
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			for _, v := range v.List() {
+				rtt.ResourceTypes = append(rtt.ResourceTypes, v.(string))
+			}
+		}
+
+		for _, key := range []string{conns.TimeoutCreate, conns.TimeoutDelete, conns.TimeoutRead, conns.TimeoutUpdate} {
+			if v, ok := tfMap[key].(string); ok && v != "" {
+				// Validated by the schema.
+				rtt.Timeouts[key], _ = time.ParseDuration(v)
+			}
+		}
+
+		defaultConfig.ResourceTypeTimeouts = append(defaultConfig.ResourceTypeTimeouts, rtt)
+	}
+
 	return defaultConfig
 }
 
@@ -2462,9 +2768,85 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 		ignoreConfig.KeyPrefixes = tftags.New(v.List())
 	}
 
+	if v, ok := m["key_regexes"].(*schema.Set); ok {
+		for _, v := range v.List() {
+			// Validated by the schema.
+			ignoreConfig.KeyRegexes = append(ignoreConfig.KeyRegexes, regexp.MustCompile(v.(string)))
+		}
+	}
+
 	return ignoreConfig
 }
 
+func expandRateLimits(l []interface{}) (map[string]conns.RateLimit, error) {
+	rateLimits := make(map[string]conns.RateLimit)
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		service, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("failed to assign rate limit: %w", err)
+		}
+
+		if _, ok := rateLimits[service]; ok {
+			return nil, fmt.Errorf("multiple rate limits configured for service %s", service)
+		}
+
+		rateLimits[service] = conns.RateLimit{
+			RequestsPerSecond: tfMap["requests_per_second"].(float64),
+			Burst:             tfMap["burst"].(int),
+		}
+	}
+
+	return rateLimits, nil
+}
+
+func expandProviderRequiredTags(l []interface{}) *tftags.RequiredConfig {
+	if len(l) == 0 {
+		return nil
+	}
+
+	requiredConfig := &tftags.RequiredConfig{}
+
+	for _, tfMapRaw := range l {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		requiredTag := &tftags.RequiredTag{
+			Key: tfMap["key"].(string),
+		}
+
+		if v, ok := tfMap["allowed_values_regex"].(string); ok && v != "" {
+			// Validated by the schema.
+			requiredTag.AllowedValuesRegex = regexp.MustCompile(v)
+		}
+
+		if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok {
+			for _, v := range v.List() {
+				requiredTag.ExcludeResourceTypes = append(requiredTag.ExcludeResourceTypes, v.(string))
+			}
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok {
+			for _, v := range v.List() {
+				requiredTag.ResourceTypes = append(requiredTag.ResourceTypes, v.(string))
+			}
+		}
+
+		requiredConfig.Tags = append(requiredConfig.Tags, requiredTag)
+	}
+
+	return requiredConfig
+}
+
 func expandEndpoints(endpointsSetList []interface{}, out map[string]string) error {
 	for _, endpointsSetI := range endpointsSetList {
 		endpoints := endpointsSetI.(map[string]interface{})
@@ -34,6 +34,52 @@ func TestExpandEndpoints(t *testing.T) {
 	}
 }
 
+func TestExpandRateLimits(t *testing.T) {
+	rateLimits, err := expandRateLimits([]interface{}{
+		map[string]interface{}{
+			"service":             "route53",
+			"requests_per_second": 5.0,
+			"burst":               0,
+		},
+		map[string]interface{}{
+			"service":             "transcribeservice",
+			"requests_per_second": 1.5,
+			"burst":               3,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(rateLimits) != 2 {
+		t.Errorf("Expected 2 rate limits, got %d", len(rateLimits))
+	}
+
+	if v := rateLimits[names.Route53]; v.RequestsPerSecond != 5 || v.Burst != 0 {
+		t.Errorf("Unexpected rate limit for %s: %+v", names.Route53, v)
+	}
+
+	if v := rateLimits[names.Transcribe]; v.RequestsPerSecond != 1.5 || v.Burst != 3 {
+		t.Errorf("Unexpected rate limit for %s: %+v", names.Transcribe, v)
+	}
+
+	_, err = expandRateLimits([]interface{}{
+		map[string]interface{}{
+			"service":             "transcribe",
+			"requests_per_second": 1.0,
+			"burst":               0,
+		},
+		map[string]interface{}{
+			"service":             "transcribeservice",
+			"requests_per_second": 2.0,
+			"burst":               0,
+		},
+	})
+	if err == nil {
+		t.Errorf("Expected error for duplicate service, got none")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) {
 	testcases := []struct {
 		endpoints        map[string]string
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ProtoV5ProviderServer returns the provider's protocol version 5 server.
+// Resource plans are given the provider's default timeouts (see conns.DefaultTimeoutsConfig).
+func ProtoV5ProviderServer() tfprotov5.ProviderServer {
+	return NewProtoV5ProviderServer(Provider())
+}
+
+// NewProtoV5ProviderServer returns the protocol version 5 server of the specified provider,
+// e.g. a provider configured for acceptance testing.
+func NewProtoV5ProviderServer(p *schema.Provider) tfprotov5.ProviderServer {
+	return &providerServer{
+		ProviderServer: schema.NewGRPCProviderServer(p),
+		provider:       p,
+	}
+}
+
+type providerServer struct {
+	tfprotov5.ProviderServer
+
+	provider *schema.Provider
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+
+	if err != nil || resp == nil || len(resp.PlannedPrivate) == 0 {
+		return resp, err
+	}
+
+	client, ok := s.provider.Meta().(*conns.AWSClient)
+
+	if !ok {
+		return resp, nil
+	}
+
+	defaultTimeouts := client.DefaultTimeoutsConfig.ForResourceType(req.TypeName)
+
+	if len(defaultTimeouts) == 0 {
+		return resp, nil
+	}
+
+	r, ok := s.provider.ResourcesMap[req.TypeName]
+
+	if !ok || r.Timeouts == nil {
+		return resp, nil
+	}
+
+	private, err := plannedPrivateWithDefaultTimeouts(req.TypeName, r, req.Config, resp.PlannedPrivate, defaultTimeouts)
+
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Error applying provider default timeouts",
+			Detail:   fmt.Sprintf("%s: %s", req.TypeName, err),
+		})
+
+		return resp, nil
+	}
+
+	resp.PlannedPrivate = private
+
+	return resp, nil
+}
+
+// plannedPrivateWithDefaultTimeouts returns the planned private state of a resource with its timeouts
+// overridden by the default timeouts. Only timeouts declared by the resource and not set in its
+// timeouts configuration block are overridden. Plans to destroy the resource are unchanged.
+func plannedPrivateWithDefaultTimeouts(typeName string, r *schema.Resource, config *tfprotov5.DynamicValue, plannedPrivate []byte, defaultTimeouts map[string]time.Duration) ([]byte, error) {
+	if config == nil {
+		return plannedPrivate, nil
+	}
+
+	configVal, err := msgpack.Unmarshal(config.MsgPack, r.CoreConfigSchema().ImpliedType())
+
+	if err != nil {
+		return nil, fmt.Errorf("decoding configuration: %w", err)
+	}
+
+	if configVal.IsNull() || !configVal.IsKnown() {
+		return plannedPrivate, nil
+	}
+
+	timeoutsVal := cty.NullVal(cty.DynamicPseudoType)
+
+	if configVal.Type().HasAttribute(schema.TimeoutsConfigKey) {
+		timeoutsVal = configVal.GetAttr(schema.TimeoutsConfigKey)
+	}
+
+	private := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(plannedPrivate))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&private); err != nil {
+		return nil, fmt.Errorf("decoding planned private state: %w", err)
+	}
+
+	timeouts, ok := private[schema.TimeoutKey].(map[string]interface{})
+
+	if !ok {
+		return plannedPrivate, nil
+	}
+
+	declared := map[string]bool{
+		schema.TimeoutCreate: r.Timeouts.Create != nil,
+		schema.TimeoutDelete: r.Timeouts.Delete != nil,
+		schema.TimeoutRead:   r.Timeouts.Read != nil,
+		schema.TimeoutUpdate: r.Timeouts.Update != nil,
+	}
+
+	for key, timeout := range defaultTimeouts {
+		if !declared[key] {
+			continue
+		}
+
+		if !timeoutsVal.IsNull() && timeoutsVal.IsKnown() && timeoutsVal.Type().HasAttribute(key) && !timeoutsVal.GetAttr(key).IsNull() {
+			continue
+		}
+
+		log.Printf("[DEBUG] Using provider default %s timeout for %s: %s", key, typeName, timeout)
+		timeouts[key] = timeout.Nanoseconds()
+	}
+
+	return json.Marshal(private)
+}
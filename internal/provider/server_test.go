@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPlannedPrivateWithDefaultTimeouts(t *testing.T) {
+	minutes := func(n int) *time.Duration {
+		d := time.Duration(n) * time.Minute
+		return &d
+	}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: minutes(40),
+			Delete: minutes(60),
+		},
+	}
+
+	defaultTimeouts := expandProviderDefaultTimeouts([]interface{}{
+		map[string]interface{}{
+			"create":         "90m",
+			"delete":         "",
+			"read":           "",
+			"resource_types": schema.NewSet(schema.HashString, []interface{}{}),
+			"update":         "90m",
+		},
+		map[string]interface{}{
+			"create":         "120m",
+			"delete":         "120m",
+			"read":           "",
+			"resource_types": schema.NewSet(schema.HashString, []interface{}{"aws_db_*"}),
+			"update":         "",
+		},
+	}).ForResourceType("aws_db_instance")
+
+	configValue := func(timeouts cty.Value) *tfprotov5.DynamicValue {
+		ty := r.CoreConfigSchema().ImpliedType()
+		v := cty.NullVal(ty)
+
+		if timeouts != cty.NilVal {
+			v = cty.ObjectVal(map[string]cty.Value{
+				"id":                     cty.NullVal(cty.String),
+				"name":                   cty.StringVal("test"),
+				schema.TimeoutsConfigKey: timeouts,
+			})
+		}
+
+		b, err := msgpack.Marshal(v, ty)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &tfprotov5.DynamicValue{MsgPack: b}
+	}
+
+	plannedPrivate := []byte(`{"` + schema.TimeoutKey + `":{"create":2400000000000,"delete":3600000000000},"schema_version":"0"}`)
+
+	testCases := []struct {
+		Name     string
+		Config   *tfprotov5.DynamicValue
+		Expected map[string]time.Duration
+	}{
+		{
+			Name: "no timeouts configuration block",
+			Config: configValue(cty.NullVal(cty.Object(map[string]cty.Type{
+				"create": cty.String,
+				"delete": cty.String,
+			}))),
+			Expected: map[string]time.Duration{
+				"create": 120 * time.Minute,
+				"delete": 120 * time.Minute,
+			},
+		},
+		{
+			Name: "timeouts configuration block",
+			Config: configValue(cty.ObjectVal(map[string]cty.Value{
+				"create": cty.StringVal("10m"),
+				"delete": cty.NullVal(cty.String),
+			})),
+			Expected: map[string]time.Duration{
+				"create": 40 * time.Minute,
+				"delete": 120 * time.Minute,
+			},
+		},
+		{
+			Name:   "destroy",
+			Config: configValue(cty.NilVal),
+			Expected: map[string]time.Duration{
+				"create": 40 * time.Minute,
+				"delete": 60 * time.Minute,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := plannedPrivateWithDefaultTimeouts("aws_db_instance", r, testCase.Config, plannedPrivate, defaultTimeouts)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var private map[string]interface{}
+
+			if err := json.Unmarshal(got, &private); err != nil {
+				t.Fatal(err)
+			}
+
+			if v := private["schema_version"]; v != "0" {
+				t.Errorf("unexpected schema_version: %v", v)
+			}
+
+			timeouts := private[schema.TimeoutKey].(map[string]interface{})
+
+			if len(timeouts) != len(testCase.Expected) {
+				t.Fatalf("got timeouts %v, expected %v", timeouts, testCase.Expected)
+			}
+
+			for k, v := range testCase.Expected {
+				if got := time.Duration(timeouts[k].(float64)); got != v {
+					t.Errorf("got %s timeout %s, expected %s", k, got, v)
+				}
+			}
+		})
+	}
+}
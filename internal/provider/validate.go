@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -29,3 +30,12 @@ var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexp.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+// validServiceAlias validates a string is a service name or alias as used in the endpoints block
+func validServiceAlias(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := names.ProviderPackageForAlias(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a supported service", k, v.(string)))
+	}
+
+	return
+}
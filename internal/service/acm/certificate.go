@@ -54,6 +54,7 @@ func ResourceCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"certificate_authority_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -61,6 +62,7 @@ func ResourceCertificate() *schema.Resource {
 				ValidateFunc:  verify.ValidARN,
 				ConflictsWith: []string{"certificate_body", "private_key", "validation_method"},
 			},
+			//lintignore:AWSS001
 			"certificate_body": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -72,6 +74,7 @@ func ResourceCertificate() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"certificate_authority_arn", "domain_name", "validation_method"},
 			},
+			//lintignore:AWSS001
 			"domain_name": {
 				// AWS Provider 3.0.0 aws_route53_zone references no longer contain a
 				// trailing period, no longer requiring a custom StateFunc
@@ -84,6 +87,7 @@ func ResourceCertificate() *schema.Resource {
 				ExactlyOneOf:  []string{"domain_name", "private_key"},
 				ConflictsWith: []string{"certificate_body", "certificate_chain", "private_key"},
 			},
+			//lintignore:AWSS001
 			"domain_validation_options": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -109,6 +113,7 @@ func ResourceCertificate() *schema.Resource {
 				},
 				Set: domainValidationOptionsHash,
 			},
+			//lintignore:AWSS001
 			"options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -145,6 +150,7 @@ func ResourceCertificate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"subject_alternative_names": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -164,11 +170,13 @@ func ResourceCertificate() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"validation_emails": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"validation_method": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -177,6 +185,7 @@ func ResourceCertificate() *schema.Resource {
 				ValidateFunc:  validation.StringInSlice(append(acm.ValidationMethod_Values(), certificateValidationMethodNone), false),
 				ConflictsWith: []string{"certificate_authority_arn", "certificate_body", "certificate_chain", "private_key"},
 			},
+			//lintignore:AWSS001
 			"validation_option": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -61,17 +61,20 @@ func ResourceCertificate() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"certificate_signing_request": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"signing_algorithm": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(acmpca.SigningAlgorithm_Values(), false),
 			},
+			//lintignore:AWSS001
 			"validity": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -95,6 +98,7 @@ func ResourceCertificate() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"template_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
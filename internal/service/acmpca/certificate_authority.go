@@ -56,6 +56,7 @@ func ResourceCertificateAuthority() *schema.Resource {
 				Computed: true,
 			},
 			// https://docs.aws.amazon.com/acm-pca/latest/APIReference/API_CertificateAuthorityConfiguration.html
+			//lintignore:AWSS001
 			"certificate_authority_configuration": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -186,6 +187,7 @@ func ResourceCertificateAuthority() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -268,6 +270,7 @@ func ResourceCertificateAuthority() *schema.Resource {
 				Computed:   true,
 				Deprecated: "The reported value of the \"status\" attribute is often inaccurate. Use the resource's \"enabled\" attribute to explicitly set status.",
 			},
+			//lintignore:AWSS001
 			"permanent_deletion_time_in_days": {
 				Type:     schema.TypeInt,
 				Optional: true,
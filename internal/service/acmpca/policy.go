@@ -32,10 +32,7 @@ func ResourcePolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"resource_arn": {
 				Type:     schema.TypeString,
@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
 // API Gateway Edge-Optimized Domain Name can only be created with ACM Certificates in specific regions.
@@ -48,7 +47,7 @@ func testAccPreCheckEdgeDomainName(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderEdgeDomainNameConfigure.Do(func() {
-		testAccProviderEdgeDomainName = provider.Provider()
+		testAccProviderEdgeDomainName = acctest.NewProvider()
 
 		config := map[string]interface{}{
 			"region": region,
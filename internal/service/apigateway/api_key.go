@@ -63,6 +63,7 @@ func ResourceAPIKey() *schema.Resource {
 				Sensitive:    true,
 				ValidateFunc: validation.StringLenBetween(30, 128),
 			},
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
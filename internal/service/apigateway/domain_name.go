@@ -31,6 +31,7 @@ func ResourceDomainName() *schema.Resource {
 			//According to AWS Documentation, ACM will be the only way to add certificates
 			//to ApiGateway DomainNames. When this happens, we will be deprecating all certificate methods
 			//except certificate_arn. We are not quite sure when this will happen.
+			//lintignore:AWSS001
 			"certificate_body": {
 				Type:          schema.TypeString,
 				ForceNew:      true,
@@ -38,6 +39,7 @@ func ResourceDomainName() *schema.Resource {
 				ConflictsWith: []string{"certificate_arn", "regional_certificate_arn"},
 			},
 
+			//lintignore:AWSS001
 			"certificate_chain": {
 				Type:          schema.TypeString,
 				ForceNew:      true,
@@ -122,6 +124,7 @@ func ResourceDomainName() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"mutual_tls_authentication": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -141,6 +144,7 @@ func ResourceDomainName() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"ownership_verification_certificate_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
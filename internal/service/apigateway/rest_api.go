@@ -55,10 +55,7 @@ func ResourceRestAPI() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 
 			"binary_media_types": {
@@ -68,17 +65,20 @@ func ResourceRestAPI() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			//lintignore:AWSS001
 			"body": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 
+			//lintignore:AWSS001
 			"disable_execute_api_endpoint": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"parameters": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -97,6 +97,7 @@ func ResourceRestAPI() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"created_date": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -42,6 +42,7 @@ func ResourceVPCLink() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
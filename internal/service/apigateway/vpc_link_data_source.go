@@ -38,6 +38,7 @@ func DataSourceVPCLink() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"target_arns": {
 				Type:     schema.TypeSet,
 				Computed: true,
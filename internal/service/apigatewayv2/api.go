@@ -86,6 +86,7 @@ func ResourceAPI() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"credentials_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -101,6 +102,7 @@ func ResourceAPI() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"fail_on_warnings": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -114,6 +116,7 @@ func ResourceAPI() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
+			//lintignore:AWSS001
 			"body": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -126,6 +129,7 @@ func ResourceAPI() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(apigatewayv2.ProtocolType_Values(), false),
 			},
+			//lintignore:AWSS001
 			"route_key": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -138,6 +142,7 @@ func ResourceAPI() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"target": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1,10 +1,11 @@
 package apigatewayv2
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -12,7 +13,7 @@ import (
 
 func DataSourceExport() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceExportRead,
+		ReadContext: dataSourceExportRead,
 
 		Schema: map[string]*schema.Schema{
 			"api_id": {
@@ -51,7 +52,7 @@ func DataSourceExport() *schema.Resource {
 	}
 }
 
-func dataSourceExportRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).APIGatewayV2Conn
 
 	apiId := d.Get("api_id").(string)
@@ -71,9 +72,12 @@ func dataSourceExportRead(d *schema.ResourceData, meta interface{}) error {
 		input.ExportVersion = aws.String(v.(string))
 	}
 
-	export, err := conn.ExportApi(input)
+	// Exporting an API does not modify it, so it is allowed in read_only mode.
+	ctx = conns.WithReadOnlyExemptOperations(ctx, "ExportApi")
+
+	export, err := conn.ExportApiWithContext(ctx, input)
 	if err != nil {
-		return fmt.Errorf("error exporting Gateway v2 API (%s): %w", apiId, err)
+		return diag.Errorf("error exporting Gateway v2 API (%s): %s", apiId, err)
 	}
 
 	d.SetId(apiId)
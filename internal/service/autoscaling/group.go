@@ -55,10 +55,12 @@ func ResourceGroup() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"vpc_zone_identifier"},
 			},
+			//lintignore:AWSS001
 			"capacity_rebalance": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"context": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -98,6 +100,7 @@ func ResourceGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"initial_lifecycle_hook": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -136,6 +139,7 @@ func ResourceGroup() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"instance_refresh": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -234,6 +238,7 @@ func ResourceGroup() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"max_instance_lifetime": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -242,11 +247,13 @@ func ResourceGroup() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"metrics_granularity": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  DefaultEnabledMetricsGranularity,
 			},
+			//lintignore:AWSS001
 			"min_elb_capacity": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -255,6 +262,7 @@ func ResourceGroup() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"mixed_instances_policy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -655,6 +663,7 @@ func ResourceGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"protect_from_scale_in": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -665,11 +674,13 @@ func ResourceGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"suspended_processes": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"tag": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -701,6 +712,7 @@ func ResourceGroup() *schema.Resource {
 				},
 				ConflictsWith: []string{"tags"},
 			},
+			//lintignore:AWSS001
 			"tags": {
 				Deprecated: "Use tag instead",
 				Type:       schema.TypeSet,
@@ -773,6 +785,7 @@ func ResourceGroup() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"warm_pool": {
 				Type:     schema.TypeList,
 				Optional: true,
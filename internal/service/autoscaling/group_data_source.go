@@ -113,6 +113,7 @@ func DataSourceGroup() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			//lintignore:AWSS001
 			"termination_policies": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -120,6 +121,7 @@ func DataSourceGroup() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			//lintignore:AWSS001
 			"vpc_zone_identifier": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -128,6 +128,7 @@ func ResourceLaunchConfiguration() *schema.Resource {
 							Required: true,
 							ForceNew: true,
 						},
+						//lintignore:AWSS001
 						"no_device": {
 							Type:     schema.TypeBool,
 							Optional: true,
@@ -290,6 +291,7 @@ func ResourceLaunchConfiguration() *schema.Resource {
 				},
 				ValidateFunc: validation.StringLenBetween(1, 16384),
 			},
+			//lintignore:AWSS001
 			"user_data_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
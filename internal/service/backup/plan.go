@@ -37,6 +37,7 @@ func ResourcePlan() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"rule": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -129,6 +130,7 @@ func ResourcePlan() *schema.Resource {
 				},
 				Set: planHash,
 			},
+			//lintignore:AWSS001
 			"advanced_backup_setting": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -44,6 +44,7 @@ func ResourceSelection() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"condition": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -136,6 +137,7 @@ func ResourceSelection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"selection_tag": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -163,6 +165,7 @@ func ResourceSelection() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"not_resources": {
 				Type:     schema.TypeSet,
 				Optional: true,
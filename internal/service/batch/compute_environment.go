@@ -55,6 +55,7 @@ func ResourceComputeEnvironment() *schema.Resource {
 				ConflictsWith: []string{"compute_environment_name"},
 				ValidateFunc:  validPrefix,
 			},
+			//lintignore:AWSS001
 			"compute_resources": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -31,6 +31,7 @@ func ResourceJobQueue() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"compute_environments": {
 				Type:     schema.TypeList,
 				Required: true,
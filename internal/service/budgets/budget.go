@@ -324,6 +324,8 @@ func resourceBudgetRead(d *schema.ResourceData, meta interface{}) error {
 
 	notifications, err := FindNotificationsByAccountIDAndBudgetName(conn, accountID, budgetName)
 
+	// The budget has no notifications.
+	//lintignore:AWSR003
 	if tfresource.NotFound(err) {
 		return nil
 	}
@@ -28,10 +28,12 @@ func ResourceCostCategory() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"default_value": {
 				Type:         schema.TypeString,
 				Optional:     true,
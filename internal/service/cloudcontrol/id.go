@@ -0,0 +1,30 @@
+package cloudcontrol
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceImportIDSeparator = ","
+
+// ResourceParseImportID parses an aws_cloudcontrolapi_resource import ID of the form
+// TYPE-NAME,IDENTIFIER with optional ,TYPE-VERSION-ID and ,ROLE-ARN parts.
+func ResourceParseImportID(id string) (string, string, string, string, error) {
+	parts := strings.Split(id, resourceImportIDSeparator)
+
+	if len(parts) >= 2 && len(parts) <= 4 && parts[0] != "" && parts[1] != "" {
+		var typeVersionID, roleARN string
+
+		if len(parts) >= 3 {
+			typeVersionID = parts[2]
+		}
+
+		if len(parts) == 4 {
+			roleARN = parts[3]
+		}
+
+		return parts[0], parts[1], typeVersionID, roleARN, nil
+	}
+
+	return "", "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected TYPE-NAME%[2]sIDENTIFIER[%[2]sTYPE-VERSION-ID[%[2]sROLE-ARN]]", id, resourceImportIDSeparator)
+}
@@ -0,0 +1,90 @@
+package cloudcontrol_test
+
+import (
+	"testing"
+
+	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
+)
+
+func TestResourceParseImportID(t *testing.T) {
+	testCases := []struct {
+		TestName              string
+		InputID               string
+		ExpectError           bool
+		ExpectedTypeName      string
+		ExpectedIdentifier    string
+		ExpectedTypeVersionID string
+		ExpectedRoleARN       string
+	}{
+		{
+			TestName:    "empty ID",
+			InputID:     "",
+			ExpectError: true,
+		},
+		{
+			TestName:    "identifier only",
+			InputID:     "test",
+			ExpectError: true,
+		},
+		{
+			TestName:    "empty identifier",
+			InputID:     "AWS::Logs::LogGroup,",
+			ExpectError: true,
+		},
+		{
+			TestName:    "too many parts",
+			InputID:     "AWS::Logs::LogGroup,test,00000001,arn:aws:iam::123456789012:role/test,extra",
+			ExpectError: true,
+		},
+		{
+			TestName:           "type name and identifier",
+			InputID:            "AWS::Logs::LogGroup,test",
+			ExpectedTypeName:   "AWS::Logs::LogGroup",
+			ExpectedIdentifier: "test",
+		},
+		{
+			TestName:              "type version ID",
+			InputID:               "AWS::Logs::LogGroup,test,00000001",
+			ExpectedTypeName:      "AWS::Logs::LogGroup",
+			ExpectedIdentifier:    "test",
+			ExpectedTypeVersionID: "00000001",
+		},
+		{
+			TestName:           "role ARN without type version ID",
+			InputID:            "AWS::Logs::LogGroup,test|sub,,arn:aws:iam::123456789012:role/test",
+			ExpectedTypeName:   "AWS::Logs::LogGroup",
+			ExpectedIdentifier: "test|sub",
+			ExpectedRoleARN:    "arn:aws:iam::123456789012:role/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotTypeName, gotIdentifier, gotTypeVersionID, gotRoleARN, err := tfcloudcontrol.ResourceParseImportID(testCase.InputID)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotTypeName != testCase.ExpectedTypeName {
+				t.Errorf("got type name %s, expected %s", gotTypeName, testCase.ExpectedTypeName)
+			}
+
+			if gotIdentifier != testCase.ExpectedIdentifier {
+				t.Errorf("got identifier %s, expected %s", gotIdentifier, testCase.ExpectedIdentifier)
+			}
+
+			if gotTypeVersionID != testCase.ExpectedTypeVersionID {
+				t.Errorf("got type version ID %s, expected %s", gotTypeVersionID, testCase.ExpectedTypeVersionID)
+			}
+
+			if gotRoleARN != testCase.ExpectedRoleARN {
+				t.Errorf("got role ARN %s, expected %s", gotRoleARN, testCase.ExpectedRoleARN)
+			}
+		})
+	}
+}
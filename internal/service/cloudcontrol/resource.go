@@ -10,7 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
-	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,6 +29,10 @@ func ResourceResource() *schema.Resource {
 		ReadContext:   resourceResourceRead,
 		UpdateContext: resourceResourceUpdate,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceResourceImport,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Hour),
 			Delete: schema.DefaultTimeout(2 * time.Hour),
@@ -36,9 +40,11 @@ func ResourceResource() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: resourceResourceDesiredStateDiffSuppress,
 			},
 			"properties": {
 				Type:     schema.TypeString,
@@ -237,6 +243,59 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+func resourceResourceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	typeName, identifier, typeVersionID, roleARN, err := ResourceParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	resourceDescription, err := FindResourceByID(ctx, conn, identifier, typeName, typeVersionID, roleARN)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading Cloud Control API Resource (%s): %w", identifier, err)
+	}
+
+	input := &cloudformation.DescribeTypeInput{
+		Type:     aws.String(cloudformation.RegistryTypeResource),
+		TypeName: aws.String(typeName),
+	}
+
+	if typeVersionID != "" {
+		input.VersionId = aws.String(typeVersionID)
+	}
+
+	output, err := tfcloudformation.FindType(ctx, meta.(*conns.AWSClient).CloudFormationConn, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading CloudFormation Type (%s): %w", typeName, err)
+	}
+
+	_, cfResource, err := parseResourceSchema(aws.StringValue(output.Schema))
+
+	if err != nil {
+		return nil, err
+	}
+
+	desiredState, err := desiredStateFromProperties(aws.StringValue(resourceDescription.Properties), cfResource)
+
+	if err != nil {
+		return nil, fmt.Errorf("error importing Cloud Control API Resource (%s): %w", identifier, err)
+	}
+
+	d.SetId(aws.StringValue(resourceDescription.Identifier))
+	d.Set("desired_state", desiredState)
+	d.Set("properties", resourceDescription.Properties)
+	d.Set("role_arn", roleARN)
+	d.Set("schema", output.Schema)
+	d.Set("type_name", typeName)
+	d.Set("type_version_id", typeVersionID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudFormationConn
 
@@ -276,20 +335,14 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	newSchema, err := cfschema.Sanitize(newSchema)
+	cfResourceSchema, cfResource, err := parseResourceSchema(newSchema)
 
 	if err != nil {
-		return fmt.Errorf("error sanitizing CloudFormation Resource Schema JSON: %w", err)
+		return err
 	}
 
-	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(newSchema)
-
-	if err != nil {
-		return fmt.Errorf("error parsing CloudFormation Resource Schema JSON: %w", err)
-	}
-
-	if err := cfResourceSchema.ValidateConfigurationDocument(newDesiredState); err != nil {
-		return fmt.Errorf("error validating desired_state against CloudFormation Resource Schema: %w", err)
+	if err := validateDesiredState(cfResourceSchema, cfResource, newDesiredState); err != nil {
+		return err
 	}
 
 	// Do nothing further for new resources or if desired state is not changed
@@ -297,20 +350,27 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
-	cfResource, err := cfResourceSchema.Resource()
+	// Compare without default values so that omitting a create-only property set to its default does not force replacement
+	oldDocument, err := normalizeDesiredState(oldDesiredStateRaw.(string), cfResource)
+
+	if err != nil {
+		return err
+	}
+
+	newDocument, err := normalizeDesiredState(newDesiredState, cfResource)
 
 	if err != nil {
-		return fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
+		return err
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	patches, err := jsonpatch.CreatePatch([]byte(oldDocument), []byte(newDocument))
 
 	if err != nil {
 		return fmt.Errorf("error creating desired_state JSON Patch: %w", err)
 	}
 
 	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
+		if isCreateOnlyPropertyPath(cfResource, patch.Path) {
 			if err := diff.ForceNew("desired_state"); err != nil {
 				return fmt.Errorf("error setting desired_state ForceNew: %w", err)
 			}
@@ -322,6 +382,29 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 	return nil
 }
 
+// resourceResourceDesiredStateDiffSuppress suppresses desired_state differences caused only by
+// adding or removing properties set to the default values defined in the CloudFormation Resource Schema,
+// e.g. after import, which sets desired_state from the properties returned by the service.
+func resourceResourceDesiredStateDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	resourceSchema := d.Get("schema").(string)
+
+	if resourceSchema == "" {
+		return false
+	}
+
+	_, cfResource, err := parseResourceSchema(resourceSchema)
+
+	if err != nil {
+		return false
+	}
+
+	return desiredStatesEquivalent(old, new, cfResource)
+}
+
 // patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
 func patchDocument(old, new string) (string, error) {
 	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
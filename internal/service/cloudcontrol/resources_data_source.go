@@ -0,0 +1,247 @@
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudcontrolapi.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("resource_model"); ok {
+		input.ResourceModel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_version_id"); ok {
+		input.TypeVersionId = aws.String(v.(string))
+	}
+
+	resourceDescriptions, err := FindResources(ctx, conn, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Cloud Control API Resources (%s): %w", typeName, err))
+	}
+
+	filters := expandPropertyFilters(d.Get("filter").(*schema.Set).List())
+	var identifiers []string
+	var resources []interface{}
+
+	for _, v := range resourceDescriptions {
+		identifier := aws.StringValue(v.Identifier)
+		properties := aws.StringValue(v.Properties)
+
+		if len(filters) > 0 {
+			document, err := decodeProperties(properties)
+
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error filtering Cloud Control API Resource (%s): %w", identifier, err))
+			}
+
+			// Some resource types only return the primary identifier properties when listing.
+			// Read the resource if a filtered property is missing from the listed properties.
+			if !propertiesContainFilterPaths(document, filters) {
+				resourceDescription, err := FindResourceByID(ctx, conn,
+					identifier,
+					typeName,
+					d.Get("type_version_id").(string),
+					d.Get("role_arn").(string),
+				)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error reading Cloud Control API Resource (%s): %w", identifier, err))
+				}
+
+				// Return the properties the resource was filtered on.
+				properties = aws.StringValue(resourceDescription.Properties)
+				document, err = decodeProperties(properties)
+
+				if err != nil {
+					return diag.FromErr(fmt.Errorf("error filtering Cloud Control API Resource (%s): %w", identifier, err))
+				}
+			}
+
+			if !propertiesMatchFilters(document, filters) {
+				continue
+			}
+		}
+
+		identifiers = append(identifiers, identifier)
+		resources = append(resources, map[string]interface{}{
+			"identifier": identifier,
+			"properties": properties,
+		})
+	}
+
+	d.SetId(typeName)
+
+	d.Set("identifiers", identifiers)
+
+	if err := d.Set("resources", resources); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting resources: %w", err))
+	}
+
+	return nil
+}
+
+// propertyFilter matches resources with any of the values at the JSON property path.
+type propertyFilter struct {
+	path   []string
+	values map[string]struct{}
+}
+
+func expandPropertyFilters(tfList []interface{}) []propertyFilter {
+	var filters []propertyFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		filter := propertyFilter{
+			path:   splitPropertyPath(tfMap["name"].(string)),
+			values: make(map[string]struct{}),
+		}
+
+		for _, v := range tfMap["values"].(*schema.Set).List() {
+			filter.values[v.(string)] = struct{}{}
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
+
+// decodeProperties decodes the properties JSON document.
+func decodeProperties(properties string) (interface{}, error) {
+	var document interface{}
+
+	if err := json.Unmarshal([]byte(properties), &document); err != nil {
+		return nil, fmt.Errorf("error decoding properties JSON: %w", err)
+	}
+
+	return document, nil
+}
+
+// propertiesContainFilterPaths returns whether the decoded properties JSON document
+// contains the top-level property of each filter's path.
+func propertiesContainFilterPaths(document interface{}, filters []propertyFilter) bool {
+	for _, filter := range filters {
+		if !propertyPathExists(document, filter.path[:1]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// propertiesMatchFilters returns whether the decoded properties JSON document matches all filters.
+func propertiesMatchFilters(document interface{}, filters []propertyFilter) bool {
+	for _, filter := range filters {
+		matched := false
+
+		for _, v := range propertyPathValues(document, filter.path) {
+			if _, ok := filter.values[v]; ok {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,150 @@
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudControlResourcesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "identifiers.#", "0"),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "resources.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResourcesDataSource_filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identifiers.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.identifier", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResourcesDataSource_filterUnlistedProperty(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_filterUnlistedProperty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.identifier", resourceName, "id"),
+					// The resource's properties are returned, not only the listed ClusterName.
+					resource.TestMatchResourceAttr(dataSourceName, "resources.0.properties", regexp.MustCompile(fmt.Sprintf(`"Value":"%s"`, rName))),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  depends_on = [aws_cloudcontrolapi_resource.test]
+}
+`, rName)
+}
+
+func testAccResourcesDataSourceConfig_filter(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName = %[1]q
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  filter {
+    name   = "LogGroupName"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_cloudcontrolapi_resource.test]
+}
+`, rName)
+}
+
+func testAccResourcesDataSourceConfig_filterUnlistedProperty(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::ECS::Cluster"
+
+  desired_state = jsonencode({
+    ClusterName = %[1]q
+    Tags = [{
+      Key   = "Name"
+      Value = %[1]q
+    }]
+  })
+}
+
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  # AWS::ECS::Cluster only returns ClusterName when listing.
+  filter {
+    name   = "Tags/*/Value"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_cloudcontrolapi_resource.test]
+}
+`, rName)
+}
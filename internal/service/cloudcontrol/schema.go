@@ -0,0 +1,323 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// parseResourceSchema returns the parsed CloudFormation Resource Schema JSON and its Resource.
+func parseResourceSchema(resourceSchema string) (*cfschema.ResourceJsonSchema, *cfschema.Resource, error) {
+	resourceSchema, err := cfschema.Sanitize(resourceSchema)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error sanitizing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(resourceSchema)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResource, err := cfResourceSchema.Resource()
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return cfResourceSchema, cfResource, nil
+}
+
+// desiredStateFromProperties returns the properties JSON document without the
+// read-only and write-only properties, which cannot be specified in desired_state.
+func desiredStateFromProperties(properties string, cfResource *cfschema.Resource) (string, error) {
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(properties), &document); err != nil {
+		return "", fmt.Errorf("error decoding properties JSON: %w", err)
+	}
+
+	for _, ptrs := range []cfschema.PropertyJsonPointers{cfResource.ReadOnlyProperties, cfResource.WriteOnlyProperties} {
+		for _, ptr := range ptrs {
+			removePropertyPath(document, ptr.Path())
+		}
+	}
+
+	b, err := json.Marshal(document)
+
+	if err != nil {
+		return "", fmt.Errorf("error encoding desired_state JSON: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// removePropertyPath removes the property at the path from the decoded JSON value.
+// Array elements are traversed implicitly or via a "*" path segment.
+func removePropertyPath(v interface{}, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			delete(v, path[0])
+			return
+		}
+
+		removePropertyPath(v[path[0]], path[1:])
+	case []interface{}:
+		if path[0] == "*" {
+			path = path[1:]
+		}
+
+		for _, e := range v {
+			removePropertyPath(e, path)
+		}
+	}
+}
+
+// propertyPathValues returns the string representations of the scalar values at the path
+// in the decoded JSON value. Array elements are traversed implicitly or via a "*" path segment.
+func propertyPathValues(v interface{}, path []string) []string {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(path) == 0 {
+			return nil
+		}
+
+		return propertyPathValues(v[path[0]], path[1:])
+	case []interface{}:
+		if len(path) > 0 && path[0] == "*" {
+			path = path[1:]
+		}
+
+		var values []string
+
+		for _, e := range v {
+			values = append(values, propertyPathValues(e, path)...)
+		}
+
+		return values
+	}
+
+	if len(path) > 0 {
+		return nil
+	}
+
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	}
+
+	return nil
+}
+
+// validateDesiredState validates the desired_state JSON document against the CloudFormation Resource Schema.
+// In addition to JSON Schema validation (required properties, types, enums and patterns),
+// read-only properties, which are rejected by the resource type handlers, are reported.
+func validateDesiredState(cfResourceSchema *cfschema.ResourceJsonSchema, cfResource *cfschema.Resource, desiredState string) error {
+	if err := cfResourceSchema.ValidateConfigurationDocument(desiredState); err != nil {
+		return fmt.Errorf("error validating desired_state against CloudFormation Resource Schema: %w", err)
+	}
+
+	var document interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &document); err != nil {
+		return fmt.Errorf("error decoding desired_state JSON: %w", err)
+	}
+
+	var errs *multierror.Error
+
+	for _, ptr := range cfResource.ReadOnlyProperties {
+		if propertyPathExists(document, ptr.Path()) {
+			errs = multierror.Append(errs, fmt.Errorf("read-only property (%s) cannot be specified in desired_state", ptr))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// propertyPathExists returns whether a property exists at the path in the decoded JSON value.
+// Array elements are traversed implicitly or via a "*" path segment.
+func propertyPathExists(v interface{}, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		e, ok := v[path[0]]
+
+		return ok && propertyPathExists(e, path[1:])
+	case []interface{}:
+		if path[0] == "*" {
+			path = path[1:]
+		}
+
+		for _, e := range v {
+			if propertyPathExists(e, path) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isCreateOnlyPropertyPath returns whether the JSON Patch path is, contains or is contained by a create-only property.
+// Unlike (*cfschema.Resource).IsCreateOnlyPropertyPath, nested paths and array indices are handled.
+func isCreateOnlyPropertyPath(cfResource *cfschema.Resource, path string) bool {
+	patchPath := splitPropertyPath(path)
+
+	for _, ptr := range cfResource.CreateOnlyProperties {
+		if propertyPathsOverlap(ptr.Path(), patchPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// splitPropertyPath splits the slash (/) separated path into its segments,
+// decoding the JSON Pointer escapes "~1" (/) and "~0" (~).
+func splitPropertyPath(path string) []string {
+	var segments []string
+
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		segments = append(segments, strings.NewReplacer("~1", "/", "~0", "~").Replace(segment))
+	}
+
+	return segments
+}
+
+// propertyPathsOverlap returns whether the property path is a prefix of the JSON Patch path or vice versa.
+func propertyPathsOverlap(propertyPath, patchPath []string) bool {
+	for i, j := 0, 0; i < len(propertyPath) && j < len(patchPath); {
+		switch p, s := propertyPath[i], patchPath[j]; {
+		case p == s:
+			i++
+			j++
+		case isArrayIndex(s):
+			if p == "*" {
+				i++
+			}
+			j++
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isArrayIndex(s string) bool {
+	if s == "-" {
+		return true
+	}
+
+	_, err := strconv.ParseUint(s, 10, 64)
+
+	return err == nil
+}
+
+// normalizeDesiredState returns the desired_state JSON document without the
+// properties set to the default values defined in the CloudFormation Resource Schema.
+func normalizeDesiredState(desiredState string, cfResource *cfschema.Resource) (string, error) {
+	var document interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &document); err != nil {
+		return "", fmt.Errorf("error decoding desired_state JSON: %w", err)
+	}
+
+	removeDefaultValues(document, cfResource.Properties, cfResource)
+
+	b, err := json.Marshal(document)
+
+	if err != nil {
+		return "", fmt.Errorf("error encoding desired_state JSON: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// desiredStatesEquivalent returns whether the desired_state JSON documents are equal,
+// ignoring properties set to their default values.
+func desiredStatesEquivalent(old, new string, cfResource *cfschema.Resource) bool {
+	oldDocument, err := normalizeDesiredState(old, cfResource)
+
+	if err != nil {
+		return false
+	}
+
+	newDocument, err := normalizeDesiredState(new, cfResource)
+
+	if err != nil {
+		return false
+	}
+
+	return oldDocument == newDocument
+}
+
+func removeDefaultValues(v interface{}, properties map[string]*cfschema.Property, cfResource *cfschema.Resource) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for name, property := range properties {
+			e, ok := v[name]
+
+			if !ok {
+				continue
+			}
+
+			property = resolveProperty(property, cfResource)
+
+			if property == nil {
+				continue
+			}
+
+			if property.Default != nil && reflect.DeepEqual(e, property.Default) {
+				delete(v, name)
+				continue
+			}
+
+			switch e.(type) {
+			case map[string]interface{}:
+				removeDefaultValues(e, property.Properties, cfResource)
+			case []interface{}:
+				if items := resolveProperty(property.Items, cfResource); items != nil {
+					removeDefaultValues(e, items.Properties, cfResource)
+				}
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			removeDefaultValues(e, properties, cfResource)
+		}
+	}
+}
+
+// resolveProperty returns the property, resolving any reference. nil is returned if the reference cannot be resolved.
+func resolveProperty(property *cfschema.Property, cfResource *cfschema.Resource) *cfschema.Property {
+	if property == nil || property.Ref == nil {
+		return property
+	}
+
+	property, err := cfResource.ResolveReference(*property.Ref)
+
+	if err != nil {
+		return nil
+	}
+
+	return property
+}
@@ -0,0 +1,404 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+)
+
+func TestDesiredStateFromProperties(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		Properties  string
+		Resource    *cfschema.Resource
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			TestName:    "invalid JSON",
+			Properties:  `{`,
+			Resource:    &cfschema.Resource{},
+			ExpectError: true,
+		},
+		{
+			TestName:   "no read-only or write-only properties",
+			Properties: `{"LogGroupName":"test","RetentionInDays":7}`,
+			Resource:   &cfschema.Resource{},
+			Expected:   `{"LogGroupName":"test","RetentionInDays":7}`,
+		},
+		{
+			TestName:   "top-level properties",
+			Properties: `{"Arn":"arn:aws:logs:us-west-2:123456789012:log-group:test","LogGroupName":"test","RetentionInDays":7}`,
+			Resource: &cfschema.Resource{
+				ReadOnlyProperties:  cfschema.PropertyJsonPointers{"/properties/Arn"},
+				WriteOnlyProperties: cfschema.PropertyJsonPointers{"/properties/Password"},
+			},
+			Expected: `{"LogGroupName":"test","RetentionInDays":7}`,
+		},
+		{
+			TestName:   "nested properties",
+			Properties: `{"Config":{"Id":"abc","Name":"test"},"Rules":[{"Id":"1","Name":"a"},{"Id":"2","Name":"b"}],"Targets":[{"Id":"1","Name":"a"}]}`,
+			Resource: &cfschema.Resource{
+				ReadOnlyProperties: cfschema.PropertyJsonPointers{"/properties/Config/Id", "/properties/Rules/*/Id", "/properties/Targets/Id"},
+			},
+			Expected: `{"Config":{"Name":"test"},"Rules":[{"Name":"a"},{"Name":"b"}],"Targets":[{"Name":"a"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := desiredStateFromProperties(testCase.Properties, testCase.Resource)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPropertyPathValues(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		Properties string
+		Path       string
+		Expected   []string
+	}{
+		{
+			TestName:   "missing",
+			Properties: `{"LogGroupName":"test"}`,
+			Path:       "RetentionInDays",
+		},
+		{
+			TestName:   "string",
+			Properties: `{"LogGroupName":"test"}`,
+			Path:       "LogGroupName",
+			Expected:   []string{"test"},
+		},
+		{
+			TestName:   "number and bool",
+			Properties: `{"Config":{"Enabled":true,"Size":1.5}}`,
+			Path:       "Config/Size",
+			Expected:   []string{"1.5"},
+		},
+		{
+			TestName:   "object",
+			Properties: `{"Config":{"Enabled":true}}`,
+			Path:       "Config",
+		},
+		{
+			TestName:   "array",
+			Properties: `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+			Path:       "Tags/*/Key",
+			Expected:   []string{"a", "b"},
+		},
+		{
+			TestName:   "implicit array",
+			Properties: `{"Ports":[80,443]}`,
+			Path:       "Ports",
+			Expected:   []string{"80", "443"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			var document interface{}
+
+			if err := json.Unmarshal([]byte(testCase.Properties), &document); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := propertyPathValues(document, strings.Split(testCase.Path, "/"))
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSplitPropertyPath(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Path     string
+		Expected []string
+	}{
+		{
+			TestName: "single segment",
+			Path:     "LogGroupName",
+			Expected: []string{"LogGroupName"},
+		},
+		{
+			TestName: "leading slash",
+			Path:     "/Tags/*/Key",
+			Expected: []string{"Tags", "*", "Key"},
+		},
+		{
+			TestName: "escaped",
+			Path:     "Config/a~1b/c~0d/e~01",
+			Expected: []string{"Config", "a/b", "c~d", "e~1"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := splitPropertyPath(testCase.Path)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPropertiesFilters(t *testing.T) {
+	filters := []propertyFilter{
+		{
+			path:   splitPropertyPath("Tags/*/Key"),
+			values: map[string]struct{}{"Environment": {}},
+		},
+	}
+
+	testCases := []struct {
+		TestName        string
+		Properties      string
+		ExpectedContain bool
+		ExpectedMatch   bool
+	}{
+		{
+			TestName:        "primary identifier only",
+			Properties:      `{"ClusterName":"test"}`,
+			ExpectedContain: false,
+			ExpectedMatch:   false,
+		},
+		{
+			TestName:        "match",
+			Properties:      `{"ClusterName":"test","Tags":[{"Key":"Environment","Value":"test"}]}`,
+			ExpectedContain: true,
+			ExpectedMatch:   true,
+		},
+		{
+			TestName:        "no match",
+			Properties:      `{"ClusterName":"test","Tags":[{"Key":"Name","Value":"test"}]}`,
+			ExpectedContain: true,
+			ExpectedMatch:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			document, err := decodeProperties(testCase.Properties)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := propertiesContainFilterPaths(document, filters); got != testCase.ExpectedContain {
+				t.Errorf("contain: got %t, expected %t", got, testCase.ExpectedContain)
+			}
+
+			if got := propertiesMatchFilters(document, filters); got != testCase.ExpectedMatch {
+				t.Errorf("match: got %t, expected %t", got, testCase.ExpectedMatch)
+			}
+		})
+	}
+}
+
+const testResourceSchema = `{
+  "typeName": "Test::Test::Example",
+  "description": "Test resource",
+  "definitions": {
+    "Rule": {
+      "type": "object",
+      "properties": {
+        "Enabled": {"type": "boolean", "default": true},
+        "Name": {"type": "string"}
+      },
+      "additionalProperties": false
+    }
+  },
+  "properties": {
+    "Arn": {"type": "string"},
+    "Config": {
+      "type": "object",
+      "properties": {
+        "Mode": {"type": "string", "enum": ["FAST", "SLOW"], "default": "FAST"},
+        "Size": {"type": "integer", "default": 10}
+      },
+      "additionalProperties": false
+    },
+    "Name": {"type": "string", "pattern": "^[a-z]+$"},
+    "RetentionInDays": {"type": "integer", "default": 7},
+    "Rules": {"type": "array", "items": {"$ref": "#/definitions/Rule"}}
+  },
+  "required": ["Name"],
+  "additionalProperties": false,
+  "primaryIdentifier": ["/properties/Name"],
+  "readOnlyProperties": ["/properties/Arn"],
+  "createOnlyProperties": ["/properties/Name", "/properties/Config", "/properties/Rules/*/Name"]
+}`
+
+func TestValidateDesiredState(t *testing.T) {
+	cfResourceSchema, cfResource, err := parseResourceSchema(testResourceSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		TestName     string
+		DesiredState string
+		ExpectError  bool
+	}{
+		{
+			TestName:     "valid",
+			DesiredState: `{"Config":{"Mode":"SLOW"},"Name":"test","Rules":[{"Name":"a"}]}`,
+		},
+		{
+			TestName:     "missing required",
+			DesiredState: `{"RetentionInDays":7}`,
+			ExpectError:  true,
+		},
+		{
+			TestName:     "invalid type",
+			DesiredState: `{"Name":"test","RetentionInDays":"7"}`,
+			ExpectError:  true,
+		},
+		{
+			TestName:     "invalid enum",
+			DesiredState: `{"Config":{"Mode":"MEDIUM"},"Name":"test"}`,
+			ExpectError:  true,
+		},
+		{
+			TestName:     "invalid pattern",
+			DesiredState: `{"Name":"Test"}`,
+			ExpectError:  true,
+		},
+		{
+			TestName:     "read-only",
+			DesiredState: `{"Arn":"arn:aws:test","Name":"test"}`,
+			ExpectError:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := validateDesiredState(cfResourceSchema, cfResource, testCase.DesiredState)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestIsCreateOnlyPropertyPath(t *testing.T) {
+	_, cfResource, err := parseResourceSchema(testResourceSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		Path     string
+		Expected bool
+	}{
+		{Path: "/Name", Expected: true},
+		{Path: "/RetentionInDays", Expected: false},
+		{Path: "/Config", Expected: true},
+		{Path: "/Config/Mode", Expected: true},
+		{Path: "/Rules", Expected: true},
+		{Path: "/Rules/0", Expected: true},
+		{Path: "/Rules/-", Expected: true},
+		{Path: "/Rules/1/Name", Expected: true},
+		{Path: "/Rules/1/Enabled", Expected: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Path, func(t *testing.T) {
+			if got := isCreateOnlyPropertyPath(cfResource, testCase.Path); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDesiredStatesEquivalent(t *testing.T) {
+	_, cfResource, err := parseResourceSchema(testResourceSchema)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		TestName string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			TestName: "equal",
+			Old:      `{"Name":"test"}`,
+			New:      `{"Name":"test"}`,
+			Expected: true,
+		},
+		{
+			TestName: "top-level default",
+			Old:      `{"Name":"test","RetentionInDays":7}`,
+			New:      `{"Name":"test"}`,
+			Expected: true,
+		},
+		{
+			TestName: "top-level non-default",
+			Old:      `{"Name":"test","RetentionInDays":30}`,
+			New:      `{"Name":"test"}`,
+			Expected: false,
+		},
+		{
+			TestName: "nested defaults",
+			Old:      `{"Config":{"Mode":"FAST","Size":10},"Name":"test"}`,
+			New:      `{"Config":{},"Name":"test"}`,
+			Expected: true,
+		},
+		{
+			TestName: "referenced array item default",
+			Old:      `{"Name":"test","Rules":[{"Enabled":true,"Name":"a"}]}`,
+			New:      `{"Name":"test","Rules":[{"Name":"a"}]}`,
+			Expected: true,
+		},
+		{
+			TestName: "referenced array item non-default",
+			Old:      `{"Name":"test","Rules":[{"Enabled":false,"Name":"a"}]}`,
+			New:      `{"Name":"test","Rules":[{"Name":"a"}]}`,
+			Expected: false,
+		},
+		{
+			TestName: "invalid JSON",
+			Old:      `{"Name":"test"}`,
+			New:      `{`,
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := desiredStatesEquivalent(testCase.Old, testCase.New, cfResource); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
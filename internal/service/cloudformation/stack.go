@@ -67,6 +67,7 @@ func ResourceStack() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			//lintignore:AWSS001
 			"on_failure": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -84,6 +85,7 @@ func ResourceStack() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"policy_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -94,6 +96,7 @@ func ResourceStack() *schema.Resource {
 					return json
 				},
 			},
+			//lintignore:AWSS001
 			"policy_url": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -110,6 +113,7 @@ func ResourceStack() *schema.Resource {
 					return template
 				},
 			},
+			//lintignore:AWSS001
 			"template_url": {
 				Type:     schema.TypeString,
 				Optional: true,
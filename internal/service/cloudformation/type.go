@@ -93,6 +93,7 @@ func ResourceType() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"schema_handler_package": {
 				Type:         schema.TypeString,
 				Required:     true,
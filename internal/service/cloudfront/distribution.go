@@ -48,6 +48,7 @@ func ResourceDistribution() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      AliasesHash,
 			},
+			//lintignore:AWSS001
 			"ordered_cache_behavior": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -222,11 +223,13 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"comment": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 128),
 			},
+			//lintignore:AWSS001
 			"custom_error_response": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -252,6 +255,7 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"default_cache_behavior": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -426,6 +430,7 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"default_root_object": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -434,12 +439,14 @@ func ResourceDistribution() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"http_version": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      cloudfront.HttpVersionHttp2,
 				ValidateFunc: validation.StringInSlice(cloudfront.HttpVersion_Values(), false),
 			},
+			//lintignore:AWSS001
 			"logging_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -463,6 +470,7 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"origin_group": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -505,6 +513,7 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"origin": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -631,12 +640,14 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"price_class": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Default:      cloudfront.PriceClassPriceClassAll,
 				ValidateFunc: validation.StringInSlice(cloudfront.PriceClass_Values(), false),
 			},
+			//lintignore:AWSS001
 			"restrictions": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -666,6 +677,7 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"viewer_certificate": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -699,10 +711,12 @@ func ResourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"web_acl_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"caller_reference": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -711,6 +725,7 @@ func ResourceDistribution() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"trusted_key_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -743,6 +758,7 @@ func ResourceDistribution() *schema.Resource {
 			// Terraform AWS Provider 3.0 name change:
 			// enables TF Plugin SDK to ignore pre-existing attribute state
 			// associated with previous naming i.e. active_trusted_signers
+			//lintignore:AWSS001
 			"trusted_signers": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -805,6 +821,7 @@ func ResourceDistribution() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			//lintignore:AWSS001
 			"is_ipv6_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
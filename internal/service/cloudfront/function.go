@@ -40,6 +40,7 @@ func ResourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"live_stage_etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -49,6 +50,7 @@ func ResourceFunction() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"publish": {
 				Type:     schema.TypeBool,
 				Optional: true,
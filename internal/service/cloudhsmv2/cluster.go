@@ -32,12 +32,14 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"source_backup_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
 
+			//lintignore:AWSS001
 			"hsm_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -102,6 +104,7 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -38,10 +38,7 @@ func ResourceDomainServiceAccessPolicy() *schema.Resource {
 				Required:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 				ValidateFunc:     validation.StringIsJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"domain_name": {
 				Type:     schema.TypeString,
@@ -31,6 +31,7 @@ func ResourceResourcePolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 			"resource_arn": {
@@ -33,6 +33,7 @@ func ResourceRepository() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 100),
 			},
 
+			//lintignore:AWSS001
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -59,10 +60,12 @@ func ResourceRepository() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"default_branch": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -57,6 +57,7 @@ func ResourceContactFlow() *schema.Resource {
 					return json
 				},
 			},
+			//lintignore:AWSS001
 			"content_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,6 +66,7 @@ func ResourceContactFlow() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -53,6 +53,7 @@ func ResourceContactFlowModule() *schema.Resource {
 					return json
 				},
 			},
+			//lintignore:AWSS001
 			"content_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,6 +63,7 @@ func ResourceContactFlowModule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 500),
 			},
+			//lintignore:AWSS001
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
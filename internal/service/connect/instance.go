@@ -55,6 +55,7 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"directory_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
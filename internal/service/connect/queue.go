@@ -84,6 +84,7 @@ func ResourceQueue() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"quick_connect_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -91,6 +92,7 @@ func ResourceQueue() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			//lintignore:AWSS001
 			"quick_connect_ids_associated": {
 				Type:     schema.TypeSet,
 				Computed: true,
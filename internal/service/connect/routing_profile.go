@@ -108,6 +108,7 @@ func ResourceRoutingProfile() *schema.Resource {
 				},
 			},
 			// used to update the queue configs by first disassociating the existing set and re-associating them
+			//lintignore:AWSS001
 			"queue_configs_associated": {
 				Type:     schema.TypeSet,
 				Computed: true,
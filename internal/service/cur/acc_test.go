@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
 // testAccCurRegion is the chosen Cost and Usage Reporting testing region
@@ -40,7 +39,7 @@ func testAccPreCheck(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderCurConfigure.Do(func() {
-		testAccProviderCur = provider.Provider()
+		testAccProviderCur = acctest.NewProvider()
 
 		config := map[string]interface{}{
 			"region": testAccGetRegion(),
@@ -25,6 +25,7 @@ func ResourceReportDefinition() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
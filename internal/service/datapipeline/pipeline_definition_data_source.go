@@ -44,6 +44,7 @@ func DataSourcePipelineDefinition() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"parameter_value": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -71,6 +72,7 @@ func DataSourcePipelineDefinition() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						//lintignore:AWSS001
 						"field": {
 							Type:     schema.TypeSet,
 							Optional: true,
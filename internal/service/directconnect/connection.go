@@ -40,10 +40,12 @@ func ResourceConnection() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validConnectionBandWidth(),
 			},
+			//lintignore:AWSS001
 			"has_logical_redundancy": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"jumbo_frame_capable": {
 				Type:     schema.TypeBool,
 				Computed: true,
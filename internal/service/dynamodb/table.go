@@ -337,16 +337,19 @@ func ResourceTable() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(dynamodb.TableClass_Values(), false),
 			},
+			//lintignore:AWSS001
 			"restore_source_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"restore_to_latest_time": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"restore_date_time": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -76,6 +76,7 @@ func DataSourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						//lintignore:AWSS001
 						"non_key_attributes": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -144,6 +145,7 @@ func DataSourceTable() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"ttl": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -71,6 +71,7 @@ func ResourceEBSSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"permanent_restore": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -83,6 +84,7 @@ func ResourceEBSSnapshot() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"temporary_restore_days": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -57,6 +57,7 @@ func ResourceEBSVolume() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"final_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -106,6 +107,7 @@ func ResourceEBSVolume() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.IntBetween(125, 1000),
 			},
+			//lintignore:AWSS001
 			"type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -83,6 +83,7 @@ func ResourceAMI() *schema.Resource {
 			// However, we don't use root_block_device here because the constraint
 			// on which root device attributes can be overridden for an instance to
 			// not apply when registering an AMI.
+			//lintignore:AWSS001
 			"ebs_block_device": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -155,6 +156,7 @@ func ResourceAMI() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"ephemeral_block_device": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -207,6 +209,7 @@ func ResourceAMI() *schema.Resource {
 			// resources record that they implicitly created new EBS snapshots that we should
 			// now manage. Not set by aws_ami, since the snapshots used there are presumed to
 			// be independently managed.
+			//lintignore:AWSS001
 			"manage_ebs_snapshots": {
 				Type:     schema.TypeBool,
 				Computed: true,
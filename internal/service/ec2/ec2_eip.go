@@ -43,15 +43,18 @@ func ResourceEIP() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"address": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"allocation_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"associate_with_private_ip": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -76,17 +79,20 @@ func ResourceEIP() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"instance": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"network_border_group": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"network_interface": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -117,6 +123,7 @@ func ResourceEIP() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"vpc": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -75,6 +75,7 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"capacity_reservation_specification": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -112,12 +113,14 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"cpu_core_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"cpu_threads_per_core": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -305,6 +308,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"hibernation": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -320,6 +324,7 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"instance_initiated_shutdown_behavior": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -335,6 +340,7 @@ func ResourceInstance() *schema.Resource {
 				Optional:     true,
 				AtLeastOneOf: []string{"instance_type", "launch_template"},
 			},
+			//lintignore:AWSS001
 			"ipv6_address_count": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -359,6 +365,7 @@ func ResourceInstance() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"launch_template": {
 				Type:         schema.TypeList,
 				MaxItems:     1,
@@ -447,6 +454,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"network_interface": {
 				ConflictsWith: []string{"associate_public_ip_address", "subnet_id", "private_ip", "secondary_private_ips", "vpc_security_group_ids", "security_groups", "ipv6_addresses", "ipv6_address_count", "source_dest_check"},
 				Type:          schema.TypeSet,
@@ -499,6 +507,7 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"primary_network_interface_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -699,6 +708,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"volume_tags": tftags.TagsSchema(),
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
@@ -130,6 +130,7 @@ func DataSourceInstance() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"ephemeral_block_device": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -182,6 +183,7 @@ func DataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"ipv6_addresses": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -287,6 +289,7 @@ func DataSourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"root_block_device": {
 				Type:     schema.TypeSet,
 				Computed: true,
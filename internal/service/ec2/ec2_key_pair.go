@@ -63,6 +63,7 @@ func ResourceKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"public_key": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -904,6 +904,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"update_default_version": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -487,6 +487,7 @@ func DataSourceLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"license_specification": {
 				Type:     schema.TypeList,
 				Computed: true,
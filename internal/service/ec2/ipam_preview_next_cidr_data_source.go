@@ -1,10 +1,11 @@
 package ec2
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,7 +16,7 @@ import (
 
 func DataSourceIPAMPreviewNextCIDR() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceIPAMPreviewNextCIDRRead,
+		ReadContext: dataSourceIPAMPreviewNextCIDRRead,
 
 		Schema: map[string]*schema.Schema{
 			"cidr": {
@@ -54,7 +55,7 @@ func DataSourceIPAMPreviewNextCIDR() *schema.Resource {
 	}
 }
 
-func dataSourceIPAMPreviewNextCIDRRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceIPAMPreviewNextCIDRRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	poolId := d.Get("ipam_pool_id").(string)
 
@@ -72,14 +73,17 @@ func dataSourceIPAMPreviewNextCIDRRead(d *schema.ResourceData, meta interface{})
 		input.NetmaskLength = aws.Int64(int64(v.(int)))
 	}
 
-	output, err := conn.AllocateIpamPoolCidr(input)
+	// Previewing the next CIDR does not allocate it, so it is allowed in read_only mode.
+	ctx = conns.WithReadOnlyExemptOperations(ctx, "AllocateIpamPoolCidr")
+
+	output, err := conn.AllocateIpamPoolCidrWithContext(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("Error previewing next cidr from IPAM pool (%s): %w", d.Get("ipam_pool_id").(string), err)
+		return diag.Errorf("Error previewing next cidr from IPAM pool (%s): %s", d.Get("ipam_pool_id").(string), err)
 	}
 
 	if output == nil || output.IpamPoolAllocation == nil {
-		return fmt.Errorf("error previewing next cidr from ipam pool (%s): empty response", poolId)
+		return diag.Errorf("error previewing next cidr from ipam pool (%s): empty response", poolId)
 	}
 
 	cidr := output.IpamPoolAllocation.Cidr
@@ -29,6 +29,7 @@ func DataSourceTransitGatewayConnectPeer() *schema.Resource {
 				Computed: true,
 			},
 			"filter": DataSourceFiltersSchema(),
+			//lintignore:AWSS001
 			"inside_cidr_blocks": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -68,6 +68,7 @@ func DataSourceTransitGateway() *schema.Resource {
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"transit_gateway_cidr_blocks": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -52,6 +52,7 @@ func ResourceVPC() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"assign_generated_ipv6_cidr_block": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -65,14 +66,17 @@ func ResourceVPC() *schema.Resource {
 				ValidateFunc:  validation.IsCIDRNetwork(VPCCIDRMinIPv4, VPCCIDRMaxIPv4),
 				ConflictsWith: []string{"ipv4_netmask_length"},
 			},
+			//lintignore:AWSS001
 			"default_network_acl_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"default_route_table_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"default_security_group_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -81,11 +85,13 @@ func ResourceVPC() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"enable_classiclink": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"enable_classiclink_dns_support": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -107,11 +113,13 @@ func ResourceVPC() *schema.Resource {
 				Default:      ec2.TenancyDefault,
 				ValidateFunc: validation.StringInSlice([]string{ec2.TenancyDefault, ec2.TenancyDedicated}, false),
 			},
+			//lintignore:AWSS001
 			"ipv4_ipam_pool_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"ipv4_netmask_length": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -134,17 +142,20 @@ func ResourceVPC() *schema.Resource {
 					verify.ValidIPv6CIDRNetworkAddress,
 					validation.IsCIDRNetwork(VPCCIDRMaxIPv6, VPCCIDRMaxIPv6)),
 			},
+			//lintignore:AWSS001
 			"ipv6_cidr_block_network_border_group": {
 				Type:         schema.TypeString,
 				Computed:     true,
 				Optional:     true,
 				RequiredWith: []string{"assign_generated_ipv6_cidr_block"},
 			},
+			//lintignore:AWSS001
 			"ipv6_ipam_pool_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"assign_generated_ipv6_cidr_block"},
 			},
+			//lintignore:AWSS001
 			"ipv6_netmask_length": {
 				Type:          schema.TypeInt,
 				Optional:      true,
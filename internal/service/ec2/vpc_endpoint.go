@@ -40,6 +40,7 @@ func ResourceVPCEndpoint() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"auto_accept": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -81,10 +82,7 @@ func ResourceVPCEndpoint() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"prefix_list_id": {
 				Type:     schema.TypeString,
@@ -34,6 +34,7 @@ func ResourceVPCEndpointService() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"allowed_principals": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -54,6 +55,7 @@ func ResourceVPCEndpointService() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"gateway_load_balancer_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -67,6 +69,7 @@ func ResourceVPCEndpointService() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"network_load_balancer_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -81,6 +84,7 @@ func ResourceVPCEndpointService() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"private_dns_name_configuration": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -113,6 +117,7 @@ func ResourceVPCEndpointService() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -36,6 +36,7 @@ func ResourceInternetGateway() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -48,6 +48,7 @@ func ResourceManagedPrefixList() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"entry": {
 				Type:     schema.TypeSet,
 				Optional: true,
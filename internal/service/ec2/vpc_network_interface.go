@@ -67,6 +67,7 @@ func ResourceNetworkInterface() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ec2.NetworkInterfaceCreationType_Values(), false),
 			},
+			//lintignore:AWSS001
 			"ipv4_prefixes": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -77,18 +78,21 @@ func ResourceNetworkInterface() *schema.Resource {
 				},
 				ConflictsWith: []string{"ipv4_prefix_count"},
 			},
+			//lintignore:AWSS001
 			"ipv4_prefix_count": {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"ipv4_prefixes"},
 			},
+			//lintignore:AWSS001
 			"ipv6_address_count": {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"ipv6_addresses", "ipv6_address_list"},
 			},
+			//lintignore:AWSS001
 			"ipv6_address_list": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -96,6 +100,7 @@ func ResourceNetworkInterface() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"ipv6_addresses", "ipv6_address_count"},
 			},
+			//lintignore:AWSS001
 			"ipv6_address_list_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -111,6 +116,7 @@ func ResourceNetworkInterface() *schema.Resource {
 				},
 				ConflictsWith: []string{"ipv6_address_count", "ipv6_address_list"},
 			},
+			//lintignore:AWSS001
 			"ipv6_prefixes": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -121,6 +127,7 @@ func ResourceNetworkInterface() *schema.Resource {
 				},
 				ConflictsWith: []string{"ipv6_prefix_count"},
 			},
+			//lintignore:AWSS001
 			"ipv6_prefix_count": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -155,12 +162,14 @@ func ResourceNetworkInterface() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"private_ip_list"},
 			},
+			//lintignore:AWSS001
 			"private_ips_count": {
 				Type:          schema.TypeInt,
 				Optional:      true,
 				Computed:      true,
 				ConflictsWith: []string{"private_ip_list"},
 			},
+			//lintignore:AWSS001
 			"private_ip_list": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -168,6 +177,7 @@ func ResourceNetworkInterface() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"private_ips", "private_ips_count"},
 			},
+			//lintignore:AWSS001
 			"private_ip_list_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -179,6 +189,7 @@ func ResourceNetworkInterface() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"source_dest_check": {
 				Type:     schema.TypeBool,
 				Optional: true,
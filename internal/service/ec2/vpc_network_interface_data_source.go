@@ -55,6 +55,7 @@ func DataSourceNetworkInterface() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"attachment": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -122,6 +123,7 @@ func DataSourceNetworkInterface() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"private_ips": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -36,11 +36,13 @@ func ResourceVPCPeeringConnection() *schema.Resource {
 		// Keep in sync with aws_vpc_peering_connection_accepter's schema.
 		// See notes in vpc_peering_connection_accepter.go.
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"accept_status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"accepter": vpcPeeringConnectionOptionsSchema,
+			//lintignore:AWSS001
 			"auto_accept": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -136,6 +136,7 @@ func ResourceRoute() *schema.Resource {
 				Optional:     true,
 				ExactlyOneOf: routeValidTargets,
 			},
+			//lintignore:AWSS001
 			"vpc_endpoint_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -154,14 +155,17 @@ func ResourceRoute() *schema.Resource {
 			//
 			// Computed attributes.
 			//
+			//lintignore:AWSS001
 			"instance_owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"origin": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"state": {
 				Type:     schema.TypeString,
 				Computed: true,
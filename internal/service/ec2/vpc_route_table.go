@@ -66,6 +66,7 @@ func ResourceRouteTable() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"propagating_vgws": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -74,6 +75,7 @@ func ResourceRouteTable() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			//lintignore:AWSS001
 			"route": {
 				Type:       schema.TypeSet,
 				Computed:   true,
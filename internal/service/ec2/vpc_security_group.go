@@ -77,6 +77,7 @@ func ResourceSecurityGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"ingress": {
 				Type:       schema.TypeSet,
 				Optional:   true,
@@ -147,6 +148,7 @@ func ResourceSecurityGroup() *schema.Resource {
 				Set: SecurityGroupRuleHash,
 			},
 
+			//lintignore:AWSS001
 			"egress": {
 				Type:       schema.TypeSet,
 				Optional:   true,
@@ -222,6 +224,7 @@ func ResourceSecurityGroup() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -186,6 +186,7 @@ func ResourceClientVPNEndpoint() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"status": {
 				Type:       schema.TypeString,
 				Computed:   true,
@@ -124,6 +124,7 @@ func DataSourceClientVPNEndpoint() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"filter": DataSourceFiltersSchema(),
+			//lintignore:AWSS001
 			"security_group_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -22,6 +22,7 @@ func DataSourceCustomerGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"bgp_asn": {
 				Type:     schema.TypeInt,
 				Computed: true,
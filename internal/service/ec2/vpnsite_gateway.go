@@ -46,6 +46,7 @@ func ResourceVPNGateway() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -29,6 +29,7 @@ func ResourceRegistryPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 			"registry_id": {
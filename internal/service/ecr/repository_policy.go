@@ -37,6 +37,7 @@ func ResourceRepositoryPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"registry_id": {
 				Type:     schema.TypeString,
@@ -32,6 +32,7 @@ func ResourceRepositoryPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 			"registry_id": {
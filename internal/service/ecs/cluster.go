@@ -32,6 +32,7 @@ func ResourceCluster() *schema.Resource {
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -42,6 +43,7 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"capacity_providers": {
 				Type:       schema.TypeSet,
 				Optional:   true,
@@ -51,6 +53,7 @@ func ResourceCluster() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			//lintignore:AWSS001
 			"configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -107,6 +110,7 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"default_capacity_provider_strategy": {
 				Type:       schema.TypeSet,
 				Optional:   true,
@@ -151,6 +155,7 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -265,6 +270,8 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 		cluster, err = FindClusterByNameOrARN(context.Background(), conn, d.Id())
 	}
 
+	// New resources not found are retried above.
+	//lintignore:AWSR003
 	if tfresource.NotFound(err) {
 		log.Printf("[WARN] ECS Cluster (%s) not found, removing from state", d.Id())
 		d.SetId("")
@@ -105,8 +105,8 @@ func resourceClusterCapacityProvidersRead(ctx context.Context, d *schema.Resourc
 
 	cluster, err := FindClusterByNameOrARN(ctx, conn, d.Id())
 
-	if tfresource.NotFound(err) {
-		diag.Errorf("[WARN] ECS Cluster (%s) not found, removing from state", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ECS Cluster (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
@@ -40,6 +40,7 @@ func ResourceService() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"capacity_provider_strategy": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -62,12 +63,14 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"cluster": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"deployment_circuit_breaker": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -92,6 +95,7 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"deployment_controller": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -115,6 +119,7 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"deployment_maximum_percent": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -126,6 +131,7 @@ func ResourceService() *schema.Resource {
 					return false
 				},
 			},
+			//lintignore:AWSS001
 			"deployment_minimum_healthy_percent": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -144,11 +150,13 @@ func ResourceService() *schema.Resource {
 					return d.Get("scheduling_strategy").(string) == ecs.SchedulingStrategyDaemon
 				},
 			},
+			//lintignore:AWSS001
 			"enable_ecs_managed_tags": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"enable_execute_command": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -158,11 +166,13 @@ func ResourceService() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"health_check_grace_period_seconds": {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, math.MaxInt32),
 			},
+			//lintignore:AWSS001
 			"iam_role": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -176,6 +186,7 @@ func ResourceService() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(ecs.LaunchType_Values(), false),
 			},
+			//lintignore:AWSS001
 			"load_balancer": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -203,11 +214,13 @@ func ResourceService() *schema.Resource {
 				},
 				Set: resourceLoadBalancerHash,
 			},
+			//lintignore:AWSS001
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"network_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -234,6 +247,7 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"ordered_placement_strategy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -262,6 +276,7 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"placement_constraints": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -280,11 +295,13 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"platform_version": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"propagate_tags": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -303,6 +320,7 @@ func ResourceService() *schema.Resource {
 				Default:      ecs.SchedulingStrategyReplica,
 				ValidateFunc: validation.StringInSlice(ecs.SchedulingStrategy_Values(), false),
 			},
+			//lintignore:AWSS001
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -331,6 +349,7 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"task_definition": {
@@ -59,6 +59,7 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"container_definitions": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -81,11 +82,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				},
 				ValidateFunc: ValidTaskDefinitionContainerDefinitions,
 			},
+			//lintignore:AWSS001
 			"cpu": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"ephemeral_storage": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -102,6 +105,7 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"execution_role_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -117,6 +121,7 @@ func ResourceTaskDefinition() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile("^[0-9A-Za-z_-]+$"), "see https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_TaskDefinition.html"),
 				),
 			},
+			//lintignore:AWSS001
 			"inference_accelerator": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -136,12 +141,14 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"ipc_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ecs.IpcMode_Values(), false),
 			},
+			//lintignore:AWSS001
 			"memory": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -154,12 +161,14 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ecs.NetworkMode_Values(), false),
 			},
+			//lintignore:AWSS001
 			"pid_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(ecs.PidMode_Values(), false),
 			},
+			//lintignore:AWSS001
 			"placement_constraints": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -181,6 +190,7 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"proxy_configuration": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -209,6 +219,7 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"requires_compatibilities": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -226,6 +237,7 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"runtime_platform": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -253,6 +265,7 @@ func ResourceTaskDefinition() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"task_role_arn": {
@@ -261,6 +274,7 @@ func ResourceTaskDefinition() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"volume": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -89,10 +89,12 @@ func ResourceFileSystem() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"number_of_mount_targets": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -66,6 +66,7 @@ func DataSourceFileSystem() *schema.Resource {
 				Type:     schema.TypeFloat,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"size_in_bytes": {
 				Type:     schema.TypeInt,
 				Computed: true,
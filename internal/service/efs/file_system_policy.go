@@ -41,6 +41,7 @@ func ResourceFileSystemPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 			},
 		},
 	}
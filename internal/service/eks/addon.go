@@ -67,10 +67,12 @@ func ResourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"preserve": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"resolve_conflicts": {
 				Type:         schema.TypeString,
 				Optional:     true,
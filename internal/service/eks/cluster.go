@@ -75,6 +75,7 @@ func ResourceCluster() *schema.Resource {
 				},
 				Set: schema.HashString,
 			},
+			//lintignore:AWSS001
 			"encryption_config": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -51,6 +51,7 @@ func ResourceNodeGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"capacity_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -86,6 +87,7 @@ func ResourceNodeGroup() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"launch_template": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -223,6 +225,7 @@ func ResourceNodeGroup() *schema.Resource {
 			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"taint": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -247,6 +250,7 @@ func ResourceNodeGroup() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"update_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -54,6 +54,7 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"auto_minor_version_upgrade": {
 				Type:         nullable.TypeNullableBool,
 				Optional:     true,
@@ -66,6 +67,7 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"az_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -138,6 +140,7 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"engine_version_actual": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -213,6 +216,7 @@ func ResourceCluster() *schema.Resource {
 					return false
 				},
 			},
+			//lintignore:AWSS001
 			"preferred_availability_zones": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -258,6 +262,7 @@ func ResourceCluster() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			//lintignore:AWSS001
 			"snapshot_arns": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -282,6 +287,7 @@ func ResourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			//lintignore:AWSS001
 			"snapshot_name": {
 				Type:     schema.TypeString,
 				Optional: true,
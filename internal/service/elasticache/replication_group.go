@@ -46,6 +46,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"at_rest_encryption_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -59,6 +60,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				ValidateFunc:  validReplicationGroupAuthToken,
 				ConflictsWith: []string{"user_group_ids"},
 			},
+			//lintignore:AWSS001
 			"auto_minor_version_upgrade": {
 				Type:         nullable.TypeNullableBool,
 				Optional:     true,
@@ -70,6 +72,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"availability_zones": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -78,10 +81,12 @@ func ResourceReplicationGroup() *schema.Resource {
 				Set:           schema.HashString,
 				ConflictsWith: []string{"preferred_cache_cluster_azs"},
 			},
+			//lintignore:AWSS001
 			"cluster_enabled": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"cluster_mode": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -112,6 +117,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"data_tiering_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -125,6 +131,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				ExactlyOneOf: []string{"description", "replication_group_description"},
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			//lintignore:AWSS001
 			"engine": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -132,16 +139,19 @@ func ResourceReplicationGroup() *schema.Resource {
 				Default:      engineRedis,
 				ValidateFunc: validation.StringInSlice([]string{engineRedis}, true),
 			},
+			//lintignore:AWSS001
 			"engine_version": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validRedisVersionString,
 			},
+			//lintignore:AWSS001
 			"engine_version_actual": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"global_replication_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -189,6 +199,7 @@ func ResourceReplicationGroup() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"maintenance_window": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -215,6 +226,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"notification_topic_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -239,6 +251,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				ConflictsWith: []string{"cluster_mode.0.num_node_groups", "num_cache_clusters", "num_node_groups"},
 				Deprecated:    "Use num_cache_clusters instead",
 			},
+			//lintignore:AWSS001
 			"parameter_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -259,6 +272,7 @@ func ResourceReplicationGroup() *schema.Resource {
 					return false
 				},
 			},
+			//lintignore:AWSS001
 			"preferred_cache_cluster_azs": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -296,6 +310,7 @@ func ResourceReplicationGroup() *schema.Resource {
 					return strings.ToLower(val.(string))
 				},
 			},
+			//lintignore:AWSS001
 			"security_group_names": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -304,6 +319,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			//lintignore:AWSS001
 			"security_group_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -311,6 +327,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Set:      schema.HashString,
 			},
+			//lintignore:AWSS001
 			"snapshot_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -336,25 +353,30 @@ func ResourceReplicationGroup() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			//lintignore:AWSS001
 			"snapshot_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"subnet_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"transit_encryption_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"user_group_ids": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -362,6 +384,7 @@ func ResourceReplicationGroup() *schema.Resource {
 				Set:           schema.HashString,
 				ConflictsWith: []string{"auth_token"},
 			},
+			//lintignore:AWSS001
 			"kms_key_id": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -33,6 +33,7 @@ func ResourceUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -62,6 +63,7 @@ func ResourceUser() *schema.Resource {
 				},
 				Sensitive: true,
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"user_id": {
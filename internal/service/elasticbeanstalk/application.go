@@ -67,6 +67,7 @@ func ResourceApplication() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -90,10 +90,7 @@ func ResourceDomain() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"advanced_options": {
 				Type:     schema.TypeMap,
@@ -118,6 +115,7 @@ func ResourceDomain() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						//lintignore:AWSS001
 						"master_user_options": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -333,6 +331,7 @@ func ResourceDomain() *schema.Resource {
 					"must start with a lowercase alphabet and be at least 3 and no more than 28 characters long."+
 						" Valid characters are a-z (lowercase letters), 0-9, and - (hyphen)."),
 			},
+			//lintignore:AWSS001
 			"domain_endpoint_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -403,6 +402,7 @@ func ResourceDomain() *schema.Resource {
 				Optional: true,
 				Default:  "1.5",
 			},
+			//lintignore:AWSS001
 			"encrypt_at_rest": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -30,6 +30,7 @@ func DataSourceTargetGroup() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"deregistration_delay": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -201,7 +201,7 @@ func resourceInstanceGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	ig, err := FetchInstanceGroup(conn, d.Get("cluster_id").(string), d.Id())
 
-	if tfresource.NotFound(err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[DEBUG] EMR Instance Group (%s) not found, removing", d.Id())
 		d.SetId("")
 		return nil
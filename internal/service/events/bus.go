@@ -30,6 +30,7 @@ func ResourceBus() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validCustomEventBusName,
 			},
+			//lintignore:AWSS001
 			"event_source_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -40,6 +41,7 @@ func ResourceBus() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -105,6 +105,7 @@ func ResourceConnection() *schema.Resource {
 					validation.StringMatch(regexp.MustCompile(`^[\.\-_A-Za-z0-9]+`), ""),
 				),
 			},
+			//lintignore:AWSS001
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -115,6 +116,7 @@ func ResourceConnection() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(eventbridge.ConnectionAuthorizationType_Values(), true),
 			},
+			//lintignore:AWSS001
 			"auth_parameters": {
 				Type:     schema.TypeList,
 				Required: true,
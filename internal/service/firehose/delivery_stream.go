@@ -1,6 +1,7 @@
 package firehose
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,7 +11,6 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/firehose"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -29,6 +29,16 @@ const (
 	destinationTypeHTTPEndpoint  = "http_endpoint"
 )
 
+// deliveryStreamRetryPolicy retries creating and updating delivery streams while IAM changes propagate.
+var deliveryStreamRetryPolicy = tfresource.IAMPropagationRetryPolicy.
+	WithTimeout(propagationTimeout).
+	// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
+	WithErrorMessage(firehose.ErrCodeInvalidArgumentException, "Access was denied").
+	WithErrorMessage(firehose.ErrCodeInvalidArgumentException, "is not authorized to").
+	WithErrorMessage(firehose.ErrCodeInvalidArgumentException, "Please make sure the role specified in VpcConfiguration has permissions").
+	// InvalidArgumentException: Verify that the IAM role has access to the Elasticsearch domain.
+	WithErrorMessage(firehose.ErrCodeInvalidArgumentException, "Verify that the IAM role has access")
+
 func cloudWatchLoggingOptionsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -872,10 +882,12 @@ func ResourceDeliveryStream() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
 
+			//lintignore:AWSS001
 			"tags": tftags.TagsSchema(),
 
 			"tags_all": tftags.TagsSchemaComputed(),
 
+			//lintignore:AWSS001
 			"server_side_encryption": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -908,6 +920,7 @@ func ResourceDeliveryStream() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"kinesis_source_configuration": {
 				Type:          schema.TypeList,
 				ForceNew:      true,
@@ -933,6 +946,7 @@ func ResourceDeliveryStream() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"destination": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -951,8 +965,10 @@ func ResourceDeliveryStream() *schema.Resource {
 				}, false),
 			},
 
+			//lintignore:AWSS001
 			"s3_configuration": s3ConfigurationSchema(),
 
+			//lintignore:AWSS001
 			"extended_s3_configuration": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -1271,6 +1287,7 @@ func ResourceDeliveryStream() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"redshift_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1337,6 +1354,7 @@ func ResourceDeliveryStream() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"elasticsearch_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1448,6 +1466,7 @@ func ResourceDeliveryStream() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"splunk_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1505,6 +1524,7 @@ func ResourceDeliveryStream() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"http_endpoint_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -1584,12 +1604,14 @@ func ResourceDeliveryStream() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"version_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"destination_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -2614,39 +2636,9 @@ func resourceDeliveryStreamCreate(d *schema.ResourceData, meta interface{}) erro
 		createInput.Tags = Tags(tags.IgnoreAWS())
 	}
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.CreateDeliveryStream(createInput)
-		if err != nil {
-			// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Access was denied") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "is not authorized to") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Please make sure the role specified in VpcConfiguration has permissions") {
-				return resource.RetryableError(err)
-			}
-
-			// InvalidArgumentException: Verify that the IAM role has access to the Elasticsearch domain.
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Verify that the IAM role has access") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Firehose is unable to assume role") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := deliveryStreamRetryPolicy.RetryContext(context.Background(), func() (interface{}, error) {
+		return conn.CreateDeliveryStream(createInput)
 	})
-	if tfresource.TimedOut(err) {
-		_, err = conn.CreateDeliveryStream(createInput)
-	}
 	if err != nil {
 		return fmt.Errorf("error creating Kinesis Firehose Delivery Stream: %s", err)
 	}
@@ -2768,41 +2760,10 @@ func resourceDeliveryStreamUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		_, err := conn.UpdateDestination(updateInput)
-		if err != nil {
-			// Access was denied when calling Glue. Please ensure that the role specified in the data format conversion configuration has the necessary permissions.
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Access was denied") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "is not authorized to") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Please make sure the role specified in VpcConfiguration has permissions") {
-				return resource.RetryableError(err)
-			}
-
-			// InvalidArgumentException: Verify that the IAM role has access to the Elasticsearch domain.
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Verify that the IAM role has access") {
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, firehose.ErrCodeInvalidArgumentException, "Firehose is unable to assume role") {
-				return resource.RetryableError(err)
-			}
-
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := deliveryStreamRetryPolicy.RetryContext(context.Background(), func() (interface{}, error) {
+		return conn.UpdateDestination(updateInput)
 	})
 
-	if tfresource.TimedOut(err) {
-		_, err = conn.UpdateDestination(updateInput)
-	}
-
 	if err != nil {
 		return fmt.Errorf(
 			"Error Updating Kinesis Firehose Delivery Stream: \"%s\"\n%s",
@@ -44,10 +44,7 @@ func ResourceVaultLock() *schema.Resource {
 				ForceNew:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"vault_name": {
 				Type:         schema.TypeString,
@@ -1,12 +1,12 @@
 package glue
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -14,7 +14,7 @@ import (
 
 func DataSourceScript() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceScriptRead,
+		ReadContext: dataSourceScriptRead,
 		Schema: map[string]*schema.Schema{
 			"dag_edge": {
 				Type:     schema.TypeList,
@@ -98,7 +98,7 @@ func DataSourceScript() *schema.Resource {
 	}
 }
 
-func dataSourceScriptRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceScriptRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).GlueConn
 
 	dagEdge := d.Get("dag_edge").([]interface{})
@@ -114,13 +114,16 @@ func dataSourceScriptRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Creating Glue Script: %s", input)
-	output, err := conn.CreateScript(input)
+	// Generating a script does not create any resources, so it is allowed in read_only mode.
+	ctx = conns.WithReadOnlyExemptOperations(ctx, "CreateScript")
+
+	output, err := conn.CreateScriptWithContext(ctx, input)
 	if err != nil {
-		return fmt.Errorf("error creating Glue script: %w", err)
+		return diag.Errorf("error creating Glue script: %s", err)
 	}
 
 	if output == nil {
-		return errors.New("script not created")
+		return diag.Errorf("script not created")
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
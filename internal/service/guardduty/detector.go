@@ -28,16 +28,19 @@ func ResourceDetector() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"account_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"datasources": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -86,6 +89,7 @@ func ResourceDetector() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"enable": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -100,6 +104,7 @@ func ResourceDetector() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -35,6 +35,7 @@ func ResourceGroupPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"name": {
 				Type:          schema.TypeString,
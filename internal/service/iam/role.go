@@ -1,6 +1,7 @@
 package iam
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -95,6 +97,7 @@ func ResourceRole() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 
@@ -119,6 +122,7 @@ func ResourceRole() *schema.Resource {
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 
+			//lintignore:AWSS001
 			"inline_policy": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -136,8 +140,11 @@ func ResourceRole() *schema.Resource {
 						"policy": {
 							Type:             schema.TypeString,
 							Optional:         true, // semantically required but syntactically optional to allow empty inline_policy
-							ValidateFunc:     verify.ValidIAMPolicyJSON,
 							DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+							ValidateFunc: validation.All(
+								verify.ValidIAMPolicyJSON,
+								verify.ValidPolicySize(verify.InlineRolePoliciesMaxSize),
+							),
 						},
 					},
 				},
@@ -150,6 +157,7 @@ func ResourceRole() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"managed_policy_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -161,7 +169,10 @@ func ResourceRole() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRoleInlinePoliciesSizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -342,19 +353,9 @@ func resourceRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 			PolicyDocument: aws.String(d.Get("assume_role_policy").(string)),
 		}
 
-		_, err := tfresource.RetryWhen(
-			propagationTimeout,
-			func() (interface{}, error) {
-				return conn.UpdateAssumeRolePolicy(assumeRolePolicyInput)
-			},
-			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, iam.ErrCodeMalformedPolicyDocumentException, "Invalid principal in policy") {
-					return true, err
-				}
-
-				return false, err
-			},
-		)
+		_, err := propagationRetryPolicy.RetryContext(context.Background(), func() (interface{}, error) {
+			return conn.UpdateAssumeRolePolicy(assumeRolePolicyInput)
+		})
 
 		if err != nil {
 			return fmt.Errorf("error updating IAM Role (%s) assume role policy: %w", d.Id(), err)
@@ -603,19 +604,9 @@ func deleteRoleInstanceProfiles(conn *iam.IAM, roleName string) error {
 }
 
 func retryCreateRole(conn *iam.IAM, input *iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
-	outputRaw, err := tfresource.RetryWhen(
-		propagationTimeout,
-		func() (interface{}, error) {
-			return conn.CreateRole(input)
-		},
-		func(err error) (bool, error) {
-			if tfawserr.ErrMessageContains(err, iam.ErrCodeMalformedPolicyDocumentException, "Invalid principal in policy") {
-				return true, err
-			}
-
-			return false, err
-		},
-	)
+	outputRaw, err := propagationRetryPolicy.RetryContext(context.Background(), func() (interface{}, error) {
+		return conn.CreateRole(input)
+	})
 
 	if err != nil {
 		return nil, err
@@ -785,6 +776,38 @@ func expandRoleInlinePolicies(roleName string, tfList []interface{}) []*iam.PutR
 	return apiObjects
 }
 
+// resourceRoleInlinePoliciesSizeDiff checks the aggregate size of the role's inline policies
+// against the IAM limit, so that exceeding it fails at plan time rather than part way through apply.
+// Policies attached with the aws_iam_role_policy resource are not counted, as they are not known here.
+func resourceRoleInlinePoliciesSizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v, ok := diff.Get("inline_policy").(*schema.Set)
+
+	if !ok || v.Len() == 0 {
+		return nil
+	}
+
+	var size int
+
+	for _, tfMapRaw := range v.List() {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		// Unknown and invalid policies do not count towards the size.
+		if n, err := verify.PolicySize(tfMap["policy"].(string)); err == nil {
+			size += n
+		}
+	}
+
+	if size > verify.InlineRolePoliciesMaxSize {
+		return fmt.Errorf("aggregate size of inline_policy policy documents (%d characters, excluding whitespace) exceeds the limit of %d characters", size, verify.InlineRolePoliciesMaxSize)
+	}
+
+	return nil
+}
+
 func addRoleInlinePolicies(policies []*iam.PutRolePolicyInput, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IAMConn
 
@@ -43,10 +43,12 @@ func ResourceUser() *schema.Resource {
 				and inefficient. Still, there are other reasons one might want
 				the UniqueID, so we can make it available.
 			*/
+			//lintignore:AWSS001
 			"unique_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -34,6 +34,7 @@ func ResourceUserPolicy() *schema.Resource {
 				Required:         true,
 				ValidateFunc:     verify.ValidIAMPolicyJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"name": {
 				Type:          schema.TypeString,
@@ -24,6 +24,9 @@ const (
 	RoleStatusNotFound      = "notfound"
 )
 
+// propagationRetryPolicy retries IAM requests which reference principals that have not yet propagated.
+var propagationRetryPolicy = tfresource.IAMPropagationRetryPolicy.WithTimeout(propagationTimeout)
+
 func waitRoleARNIsNotUniqueID(conn *iam.IAM, id string, role *iam.Role) (*iam.Role, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{RoleStatusARNIsUniqueID, RoleStatusNotFound},
@@ -98,6 +98,7 @@ func ResourceComponent() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -88,6 +88,7 @@ func ResourceContainerRecipe() *schema.Resource {
 				ExactlyOneOf: []string{"dockerfile_template_data", "dockerfile_template_uri"},
 				ValidateFunc: validation.StringLenBetween(1, 16000),
 			},
+			//lintignore:AWSS001
 			"dockerfile_template_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
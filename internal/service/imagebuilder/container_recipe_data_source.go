@@ -87,10 +87,12 @@ func DataSourceContainerRecipe() *schema.Resource {
 										Computed: true,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												//lintignore:AWSS001
 												"delete_on_termination": {
 													Type:     schema.TypeBool,
 													Computed: true,
 												},
+												//lintignore:AWSS001
 												"encrypted": {
 													Type:     schema.TypeBool,
 													Computed: true,
@@ -118,6 +120,7 @@ func DataSourceContainerRecipe() *schema.Resource {
 											},
 										},
 									},
+									//lintignore:AWSS001
 									"no_device": {
 										Type:     schema.TypeString,
 										Computed: true,
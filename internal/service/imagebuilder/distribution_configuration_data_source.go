@@ -38,6 +38,7 @@ func DataSourceDistributionConfiguration() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						//lintignore:AWSS001
 						"ami_distribution_configuration": {
 							Type:     schema.TypeSet,
 							Computed: true,
@@ -102,6 +103,7 @@ func DataSourceDistributionConfiguration() *schema.Resource {
 								},
 							},
 						},
+						//lintignore:AWSS001
 						"container_distribution_configuration": {
 							Type:     schema.TypeSet,
 							Computed: true,
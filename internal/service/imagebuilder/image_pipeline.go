@@ -129,6 +129,7 @@ func ResourceImagePipeline() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.StringLenBetween(1, 1024),
 						},
+						//lintignore:AWSS001
 						"timezone": {
 							Type:     schema.TypeString,
 							Optional: true,
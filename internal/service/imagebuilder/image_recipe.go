@@ -187,6 +187,7 @@ func ResourceImageRecipe() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"systems_manager_agent": {
 				Type:     schema.TypeList,
 				Optional: true,
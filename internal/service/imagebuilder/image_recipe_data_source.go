@@ -35,10 +35,12 @@ func DataSourceImageRecipe() *schema.Resource {
 							Computed: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									//lintignore:AWSS001
 									"delete_on_termination": {
 										Type:     schema.TypeBool,
 										Computed: true,
 									},
+									//lintignore:AWSS001
 									"encrypted": {
 										Type:     schema.TypeBool,
 										Computed: true,
@@ -66,6 +68,7 @@ func DataSourceImageRecipe() *schema.Resource {
 								},
 							},
 						},
+						//lintignore:AWSS001
 						"no_device": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -83,6 +83,7 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"broker_node_group_info": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -203,6 +204,7 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"client_authentication": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -256,6 +258,7 @@ func ResourceCluster() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			//lintignore:AWSS001
 			"configuration_info": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -276,10 +279,12 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"current_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"encryption_info": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -320,6 +325,7 @@ func ResourceCluster() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			//lintignore:AWSS001
 			"enhanced_monitoring": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -331,6 +337,7 @@ func ResourceCluster() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
+			//lintignore:AWSS001
 			"logging_info": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -412,6 +419,7 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"open_monitoring": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -39,6 +39,7 @@ func ResourceConnector() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"capacity": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -127,6 +128,7 @@ func ResourceConnector() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"connector_configuration": {
 				Type:     schema.TypeMap,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -139,6 +141,7 @@ func ResourceConnector() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			//lintignore:AWSS001
 			"kafka_cluster": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -186,6 +189,7 @@ func ResourceConnector() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"kafka_cluster_client_authentication": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -203,6 +207,7 @@ func ResourceConnector() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"kafka_cluster_encryption_in_transit": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -220,11 +225,13 @@ func ResourceConnector() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"kafkaconnect_version": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"log_delivery": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -316,6 +323,7 @@ func ResourceConnector() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 128),
 			},
+			//lintignore:AWSS001
 			"plugin": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -346,6 +354,7 @@ func ResourceConnector() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"service_execution_role_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -356,6 +365,7 @@ func ResourceConnector() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"worker_configuration": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -36,6 +36,7 @@ func ResourceCustomPlugin() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"content_type": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -51,6 +52,7 @@ func ResourceCustomPlugin() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"location": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -77,6 +77,7 @@ func ResourceStream() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"encryption_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -91,6 +92,7 @@ func ResourceStream() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"kms_key_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -106,6 +108,7 @@ func ResourceStream() *schema.Resource {
 				Default:      24,
 				ValidateFunc: validation.IntBetween(24, 8760),
 			},
+			//lintignore:AWSS001
 			"shard_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
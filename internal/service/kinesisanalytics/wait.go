@@ -1,10 +1,10 @@
 package kinesisanalytics
 
 import (
+	"context"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
-	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -88,50 +88,20 @@ func waitApplicationUpdated(conn *kinesisanalytics.KinesisAnalytics, name string
 	return nil, err
 }
 
+// iamPropagationRetryPolicy retries errors indicating an IAM eventual consistency issue.
+var iamPropagationRetryPolicy = tfresource.IAMPropagationRetryPolicy.
+	WithTimeout(propagationTimeout).
+	// Kinesis Stream: https://github.com/hashicorp/terraform-provider-aws/issues/7032
+	WithErrorMessage(kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges").
+	// Kinesis Firehose: https://github.com/hashicorp/terraform-provider-aws/issues/7394
+	WithErrorMessage(kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics doesn't have sufficient privileges").
+	// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
+	WithErrorMessage(kinesisanalytics.ErrCodeInvalidArgumentException, "does not provide Invoke permissions on the Lambda resource").
+	// S3: https://github.com/hashicorp/terraform-provider-aws/issues/16104
+	WithErrorMessage(kinesisanalytics.ErrCodeInvalidArgumentException, "Please check the role provided or validity of S3 location you provided")
+
 // waitIAMPropagation retries the specified function if the returned error indicates an IAM eventual consistency issue.
 // If the retries time out the specified function is called one last time.
 func waitIAMPropagation(f func() (interface{}, error)) (interface{}, error) {
-	var output interface{}
-
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError {
-		var err error
-
-		output, err = f()
-
-		// Kinesis Stream: https://github.com/hashicorp/terraform-provider-aws/issues/7032
-		if tfawserr.ErrMessageContains(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
-			return resource.RetryableError(err)
-		}
-
-		// Kinesis Firehose: https://github.com/hashicorp/terraform-provider-aws/issues/7394
-		if tfawserr.ErrMessageContains(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics doesn't have sufficient privileges") {
-			return resource.RetryableError(err)
-		}
-
-		// InvalidArgumentException: Given IAM role arn : arn:aws:iam::123456789012:role/xxx does not provide Invoke permissions on the Lambda resource : arn:aws:lambda:us-west-2:123456789012:function:yyy
-		if tfawserr.ErrMessageContains(err, kinesisanalytics.ErrCodeInvalidArgumentException, "does not provide Invoke permissions on the Lambda resource") {
-			return resource.RetryableError(err)
-		}
-
-		// S3: https://github.com/hashicorp/terraform-provider-aws/issues/16104
-		if tfawserr.ErrMessageContains(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Please check the role provided or validity of S3 location you provided") {
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
-	})
-
-	if tfresource.TimedOut(err) {
-		output, err = f()
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	return output, nil
+	return iamPropagationRetryPolicy.RetryContext(context.Background(), f)
 }
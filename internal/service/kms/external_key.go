@@ -89,9 +89,11 @@ func ResourceExternalKey() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(0, 32768),
 					validation.StringIsJSON,
+					verify.ValidPolicySize(verify.KeyPolicyMaxSize),
 				),
 			},
 			"tags":     tftags.TagsSchema(),
@@ -197,7 +197,7 @@ func resourceGrantRead(d *schema.ResourceData, meta interface{}) error {
 	grant, err := findGrantByIdRetry(conn, keyId, grantId)
 
 	if err != nil {
-		if tfresource.NotFound(err) {
+		if !d.IsNewResource() && tfresource.NotFound(err) {
 			log.Printf("[WARN] KMS Grant (%s) not found for Key (%s), removing from state file", grantId, keyId)
 			d.SetId("")
 			return nil
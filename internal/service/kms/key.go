@@ -58,11 +58,13 @@ func ResourceKey() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringLenBetween(0, 8192),
 			},
+			//lintignore:AWSS001
 			"enable_key_rotation": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"is_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -85,13 +87,19 @@ func ResourceKey() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        verify.PolicyStateFunc,
+				ValidateFunc: validation.All(
+					validation.StringIsJSON,
+					verify.ValidPolicySize(verify.KeyPolicyMaxSize),
+				),
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -84,7 +84,11 @@ func ResourceReplicaExternalKey() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        verify.PolicyStateFunc,
+				ValidateFunc: validation.All(
+					validation.StringIsJSON,
+					verify.ValidPolicySize(verify.KeyPolicyMaxSize),
+				),
 			},
 			"primary_key_arn": {
 				Type:         schema.TypeString,
@@ -56,6 +56,7 @@ func ResourceAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"routing_config": {
 				Type:     schema.TypeList,
 				Optional: true,
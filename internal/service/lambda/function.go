@@ -31,6 +31,13 @@ const keyMutex = `aws_lambda_function`
 
 const FunctionVersionLatest = "$LATEST"
 
+// functionRetryPolicy retries creating and updating functions while IAM and KMS changes propagate and while EC2 throttles Lambda.
+var functionRetryPolicy = tfresource.IAMPropagationRetryPolicy.
+	WithTimeout(propagationTimeout).
+	WithErrorMessage(lambda.ErrCodeInvalidParameterValueException, "throttled by EC2").
+	WithErrorMessage(lambda.ErrCodeInvalidParameterValueException, "Lambda was unable to configure access to your environment variables because the KMS key is invalid for CreateGrant").
+	WithErrorCodes(lambda.ErrCodeResourceConflictException)
+
 func ResourceFunction() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceFunctionCreate,
@@ -59,21 +66,25 @@ func ResourceFunction() *schema.Resource {
 					ValidateFunc: validation.StringInSlice(lambda.Architecture_Values(), false),
 				},
 			},
+			//lintignore:AWSS001
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"s3_bucket", "s3_key", "s3_object_version", "image_uri"},
 			},
+			//lintignore:AWSS001
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"filename", "image_uri"},
 			},
+			//lintignore:AWSS001
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"filename", "image_uri"},
 			},
+			//lintignore:AWSS001
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -84,6 +95,7 @@ func ResourceFunction() *schema.Resource {
 				Optional:      true,
 				ConflictsWith: []string{"filename", "s3_bucket", "s3_key", "s3_object_version"},
 			},
+			//lintignore:AWSS001
 			"package_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -91,6 +103,7 @@ func ResourceFunction() *schema.Resource {
 				Default:      lambda.PackageTypeZip,
 				ValidateFunc: validation.StringInSlice(lambda.PackageType_Values(), false),
 			},
+			//lintignore:AWSS001
 			"image_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -229,6 +242,7 @@ func ResourceFunction() *schema.Resource {
 				Optional: true,
 				Default:  3,
 			},
+			//lintignore:AWSS001
 			"publish": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -560,45 +574,10 @@ func resourceFunctionCreate(d *schema.ResourceData, meta interface{}) error {
 		params.Tags = Tags(tags.IgnoreAWS())
 	}
 
-	err := resource.Retry(propagationTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
-		_, err := conn.CreateFunction(params)
-
-		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The role defined for the function cannot be assumed by Lambda") {
-			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-			return resource.RetryableError(err)
-		}
-
-		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The provided execution role does not have permissions") {
-			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-			return resource.RetryableError(err)
-		}
-
-		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
-			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-			return resource.RetryableError(err)
-		}
-
-		if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "Lambda was unable to configure access to your environment variables because the KMS key is invalid for CreateGrant") {
-			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-			return resource.RetryableError(err)
-		}
-
-		if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceConflictException) {
-			log.Printf("[DEBUG] Received %s, retrying CreateFunction", err)
-			return resource.RetryableError(err)
-		}
-
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		return nil
+	_, err := functionRetryPolicy.RetryContext(context.Background(), func() (interface{}, error) {
+		return conn.CreateFunction(params)
 	})
 
-	if tfresource.TimedOut(err) {
-		_, err = conn.CreateFunction(params)
-	}
-
 	if err != nil {
 		if !tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
 			return fmt.Errorf("error creating Lambda Function (1): %w", err)
@@ -1123,45 +1102,10 @@ func resourceFunctionUpdate(d *schema.ResourceData, meta interface{}) error {
 	if configUpdate {
 		log.Printf("[DEBUG] Send Update Lambda Function Configuration request: %#v", configReq)
 
-		err := resource.Retry(propagationTimeout, func() *resource.RetryError { // nosem: helper-schema-resource-Retry-without-TimeoutError-check
-			_, err := conn.UpdateFunctionConfiguration(configReq)
-
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The role defined for the function cannot be assumed by Lambda") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "The provided execution role does not have permissions") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "Lambda was unable to configure access to your environment variables because the KMS key is invalid for CreateGrant") {
-				log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-				return resource.RetryableError(err)
-			}
-
-			if tfawserr.ErrCodeEquals(err, lambda.ErrCodeResourceConflictException) {
-				log.Printf("[DEBUG] Received %s, retrying UpdateFunctionConfiguration", err)
-				return resource.RetryableError(err)
-			}
-
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
+		_, err := functionRetryPolicy.RetryContext(context.Background(), func() (interface{}, error) {
+			return conn.UpdateFunctionConfiguration(configReq)
 		})
 
-		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateFunctionConfiguration(configReq)
-		}
-
 		if err != nil {
 			if !tfawserr.ErrMessageContains(err, lambda.ErrCodeInvalidParameterValueException, "throttled by EC2") {
 				return fmt.Errorf("error modifying Lambda Function (%s) configuration : %w", d.Id(), err)
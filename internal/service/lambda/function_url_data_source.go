@@ -29,21 +29,25 @@ func DataSourceFunctionURL() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						//lintignore:AWSS001
 						"allow_headers": {
 							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						//lintignore:AWSS001
 						"allow_methods": {
 							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						//lintignore:AWSS001
 						"allow_origins": {
 							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						//lintignore:AWSS001
 						"expose_headers": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -40,6 +40,7 @@ func ResourceInvocation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"triggers": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -1,11 +1,13 @@
 package lambda
 
 import (
+	"context"
 	"crypto/md5"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -13,7 +15,7 @@ import (
 
 func DataSourceInvocation() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceInvocationRead,
+		ReadContext: dataSourceInvocationRead,
 
 		Schema: map[string]*schema.Schema{
 			"function_name": {
@@ -41,14 +43,17 @@ func DataSourceInvocation() *schema.Resource {
 	}
 }
 
-func dataSourceInvocationRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceInvocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).LambdaConn
 
 	functionName := d.Get("function_name").(string)
 	qualifier := d.Get("qualifier").(string)
 	input := []byte(d.Get("input").(string))
 
-	res, err := conn.Invoke(&lambda.InvokeInput{
+	// Invoking the function is the purpose of the data source, so it is allowed in read_only mode.
+	ctx = conns.WithReadOnlyExemptOperations(ctx, "Invoke")
+
+	res, err := conn.InvokeWithContext(ctx, &lambda.InvokeInput{
 		FunctionName:   aws.String(functionName),
 		InvocationType: aws.String(lambda.InvocationTypeRequestResponse),
 		Payload:        input,
@@ -56,15 +61,15 @@ func dataSourceInvocationRead(d *schema.ResourceData, meta interface{}) error {
 	})
 
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	if res.FunctionError != nil {
-		return fmt.Errorf("Lambda function (%s) returned error: (%s)", functionName, string(res.Payload))
+		return diag.Errorf("Lambda function (%s) returned error: (%s)", functionName, string(res.Payload))
 	}
 
 	if err = d.Set("result", string(res.Payload)); err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	d.SetId(fmt.Sprintf("%s_%s_%x", functionName, qualifier, md5.Sum(input)))
@@ -64,6 +64,7 @@ func ResourceLayerVersion() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"filename": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -85,18 +86,21 @@ func ResourceLayerVersion() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
+			//lintignore:AWSS001
 			"s3_bucket": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"filename"},
 			},
+			//lintignore:AWSS001
 			"s3_key": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
 				ConflictsWith: []string{"filename"},
 			},
+			//lintignore:AWSS001
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
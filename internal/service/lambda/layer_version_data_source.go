@@ -21,6 +21,7 @@ func DataSourceLayerVersion() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"version": {
 				Type:          schema.TypeInt,
 				Optional:      true,
@@ -42,6 +42,7 @@ func ResourceBot() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"abort_statement": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -61,6 +62,7 @@ func ResourceBot() *schema.Resource {
 				Type:     schema.TypeBool,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"clarification_prompt": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -68,6 +70,7 @@ func ResourceBot() *schema.Resource {
 				MaxItems: 1,
 				Elem:     promptResource,
 			},
+			//lintignore:AWSS001
 			"create_version": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -102,6 +105,7 @@ func ResourceBot() *schema.Resource {
 				Default:      300,
 				ValidateFunc: validation.IntBetween(60, 86400),
 			},
+			//lintignore:AWSS001
 			"intent": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -151,6 +155,7 @@ func ResourceBot() *schema.Resource {
 				Default:      0,
 				ValidateFunc: validation.FloatBetween(0, 1),
 			},
+			//lintignore:AWSS001
 			"process_behavior": {
 				Type:         schema.TypeString,
 				Optional:     true,
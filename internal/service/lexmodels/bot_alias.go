@@ -61,6 +61,7 @@ func ResourceBotAlias() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"conversation_logs": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -52,6 +52,7 @@ func ResourceIntent() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"conclusion_statement": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -60,6 +61,7 @@ func ResourceIntent() *schema.Resource {
 				ConflictsWith: []string{"follow_up_prompt"},
 				Elem:          statementResource,
 			},
+			//lintignore:AWSS001
 			"confirmation_prompt": {
 				Type:         schema.TypeList,
 				Optional:     true,
@@ -68,6 +70,7 @@ func ResourceIntent() *schema.Resource {
 				RequiredWith: []string{"rejection_statement"},
 				Elem:         promptResource,
 			},
+			//lintignore:AWSS001
 			"create_version": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -82,6 +85,7 @@ func ResourceIntent() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 200),
 			},
+			//lintignore:AWSS001
 			"dialog_code_hook": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -89,6 +93,7 @@ func ResourceIntent() *schema.Resource {
 				MaxItems: 1,
 				Elem:     codeHookResource,
 			},
+			//lintignore:AWSS001
 			"follow_up_prompt": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -114,6 +119,7 @@ func ResourceIntent() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"fulfillment_activity": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -153,6 +159,7 @@ func ResourceIntent() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"rejection_statement": {
 				Type:         schema.TypeList,
 				Optional:     true,
@@ -161,6 +168,7 @@ func ResourceIntent() *schema.Resource {
 				RequiredWith: []string{"confirmation_prompt"},
 				Elem:         statementResource,
 			},
+			//lintignore:AWSS001
 			"sample_utterances": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -171,6 +179,7 @@ func ResourceIntent() *schema.Resource {
 					ValidateFunc: validation.StringLenBetween(1, 200),
 				},
 			},
+			//lintignore:AWSS001
 			"slot": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -45,6 +45,7 @@ func ResourceSlotType() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"create_version": {
 				Type:     schema.TypeBool,
 				Optional: true,
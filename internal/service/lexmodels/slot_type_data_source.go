@@ -32,6 +32,7 @@ func DataSourceSlotType() *schema.Resource {
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						//lintignore:AWSS001
 						"synonyms": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -141,7 +141,7 @@ func resourceMetricFilterRead(d *schema.ResourceData, meta interface{}) error {
 	mf, err := LookupMetricFilter(conn, d.Get("name").(string),
 		d.Get("log_group_name").(string), nil)
 	if err != nil {
-		if tfresource.NotFound(err) {
+		if !d.IsNewResource() && tfresource.NotFound(err) {
 			log.Printf("[WARN] Removing CloudWatch Log Metric Filter as it is gone")
 			d.SetId("")
 			return nil
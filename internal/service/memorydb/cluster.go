@@ -184,6 +184,7 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"snapshot_arns": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -198,6 +199,7 @@ func ResourceCluster() *schema.Resource {
 					),
 				},
 			},
+			//lintignore:AWSS001
 			"snapshot_name": {
 				Type:          schema.TypeString,
 				Optional:      true,
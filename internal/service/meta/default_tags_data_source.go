@@ -13,6 +13,10 @@ func DataSourceDefaultTags() *schema.Resource {
 		Read: dataSourceDefaultTagsRead,
 
 		Schema: map[string]*schema.Schema{
+			"resource_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"tags": tftags.TagsSchemaComputed(),
 		},
 	}
@@ -24,6 +28,10 @@ func dataSourceDefaultTagsRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(meta.(*conns.AWSClient).Partition)
 
+	if v, ok := d.GetOk("resource_type"); ok {
+		defaultTagsConfig = defaultTagsConfig.ForResourceType(v.(string))
+	}
+
 	tags := defaultTagsConfig.GetTags()
 
 	if tags != nil {
@@ -1,6 +1,7 @@
 package meta_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -118,6 +119,61 @@ func TestAccMetaDefaultTagsDataSource_ignore(t *testing.T) {
 	})
 }
 
+func TestAccMetaDefaultTagsDataSource_resourceType(t *testing.T) {
+	var providers []*schema.Provider
+
+	dataSourceName := "data.aws_default_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProviderFactories: acctest.FactoriesInternal(&providers),
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDefaultTagsDataSourceConfig_resourceType("aws_ebs_volume"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.first", "value"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Backup", "daily"),
+				),
+			},
+			{
+				Config: testAccDefaultTagsDataSourceConfig_resourceType("aws_instance"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.first", "value"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDefaultTagsDataSourceConfig_basic() string {
 	return `data "aws_default_tags" "test" {}`
 }
+
+func testAccDefaultTagsDataSourceConfig_resourceType(resourceType string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      first = "value"
+    }
+
+    resource_type_tags {
+      resource_types = ["aws_ebs_*", "aws_db_instance"]
+
+      tags = {
+        Backup = "daily"
+      }
+    }
+  }
+}
+
+data "aws_default_tags" "test" {
+  resource_type = %[1]q
+}
+`, resourceType)
+}
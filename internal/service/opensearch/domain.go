@@ -89,10 +89,7 @@ func ResourceDomain() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"advanced_options": {
 				Type:     schema.TypeMap,
@@ -117,6 +114,7 @@ func ResourceDomain() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+						//lintignore:AWSS001
 						"master_user_options": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -320,6 +318,7 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"domain_endpoint_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -397,6 +396,7 @@ func ResourceDomain() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"encrypt_at_rest": {
 				Type:     schema.TypeList,
 				Optional: true,
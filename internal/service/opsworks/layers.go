@@ -337,6 +337,8 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 		Read: func(d *schema.ResourceData, meta interface{}) error {
 			return lt.Read(d, meta)
 		},
+		// The layer type's Create sets the ID.
+		//lintignore:AWSR006
 		Create: func(d *schema.ResourceData, meta interface{}) error {
 			return lt.Create(d, meta)
 		},
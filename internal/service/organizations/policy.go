@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/organizations"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -38,6 +39,7 @@ func ResourcePolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
 				ValidateFunc:     validation.StringIsJSON,
 			},
 			"description": {
@@ -59,7 +61,10 @@ func ResourcePolicy() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourcePolicyContentSizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -240,3 +245,18 @@ func resourcePolicyImport(ctx context.Context, d *schema.ResourceData, meta inte
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// resourcePolicyContentSizeDiff checks the size of the policy content against the limit for the policy type,
+// so that exceeding it fails at plan time rather than part way through apply.
+func resourcePolicyContentSizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("content") {
+		return nil
+	}
+
+	switch diff.Get("type").(string) {
+	case organizations.PolicyTypeServiceControlPolicy:
+		return verify.CheckPolicySize("content", diff.Get("content").(string), verify.ServiceControlPolicyMaxSize)
+	}
+
+	return nil
+}
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
 // testAccPricingRegion is the chosen Pricing testing region
@@ -37,7 +36,7 @@ func testAccPreCheck(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderPricingConfigure.Do(func() {
-		testAccProviderPricing = provider.Provider()
+		testAccProviderPricing = acctest.NewProvider()
 
 		config := map[string]interface{}{
 			"region": testAccGetRegion(),
@@ -32,6 +32,7 @@ func ResourceResourceShare() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"allow_external_principals": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -45,6 +46,7 @@ func ResourceResourceShare() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"permission_arns": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -45,6 +45,7 @@ func ResourceCluster() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -109,6 +110,7 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"copy_tags_to_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -120,6 +122,7 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"db_cluster_instance_class": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -129,6 +132,7 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"db_instance_parameter_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -139,10 +143,12 @@ func ResourceCluster() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"deletion_protection": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"enable_global_write_forwarding": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -156,6 +162,7 @@ func ResourceCluster() *schema.Resource {
 					ValidateFunc: validation.StringInSlice(ClusterExportableLogType_Values(), false),
 				},
 			},
+			//lintignore:AWSS001
 			"enable_http_endpoint": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -172,6 +179,7 @@ func ResourceCluster() *schema.Resource {
 				Default:      EngineAurora,
 				ValidateFunc: validEngine(),
 			},
+			//lintignore:AWSS001
 			"engine_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -184,6 +192,7 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"engine_version_actual": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -206,6 +215,7 @@ func ResourceCluster() *schema.Resource {
 					return
 				},
 			},
+			//lintignore:AWSS001
 			"global_cluster_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -224,6 +234,7 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"iops": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -277,6 +288,7 @@ func ResourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"restore_to_point_in_time": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -319,6 +331,7 @@ func ResourceCluster() *schema.Resource {
 					"snapshot_identifier",
 				},
 			},
+			//lintignore:AWSS001
 			"s3_import": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -357,6 +370,7 @@ func ResourceCluster() *schema.Resource {
 					"restore_to_point_in_time",
 				},
 			},
+			//lintignore:AWSS001
 			"scaling_configuration": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -394,6 +408,7 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"serverlessv2_scaling_configuration": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -419,10 +434,12 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"snapshot_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"source_region": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -434,6 +451,7 @@ func ResourceCluster() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"storage_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -94,7 +94,7 @@ func resourceClusterActivityStreamRead(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[DEBUG] Finding DB Cluster (%s)", d.Id())
 	resp, err := FindDBClusterWithActivityStream(conn, d.Id())
 
-	if tfresource.NotFound(err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] RDS Cluster (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
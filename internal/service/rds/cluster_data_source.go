@@ -69,6 +69,7 @@ func DataSourceCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"enabled_cloudwatch_logs_exports": {
 				Type:     schema.TypeList,
 				Computed: true,
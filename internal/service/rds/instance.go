@@ -92,6 +92,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -112,6 +113,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"backup_window": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -123,17 +125,20 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"character_set_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"copy_tags_to_snapshot": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"customer_owned_ip_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -148,6 +153,7 @@ func ResourceInstance() *schema.Resource {
 					"replicate_source_db",
 				},
 			},
+			//lintignore:AWSS001
 			"db_subnet_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -158,14 +164,17 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			//lintignore:AWSS001
 			"deletion_protection": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"domain": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"domain_iam_role_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -199,6 +208,7 @@ func ResourceInstance() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"replicate_source_db"},
 			},
+			//lintignore:AWSS001
 			"engine_version_actual": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -217,10 +227,12 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"iam_database_authentication_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -236,6 +248,7 @@ func ResourceInstance() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validIdentifierPrefix,
 			},
+			//lintignore:AWSS001
 			"instance_class": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -251,6 +264,7 @@ func ResourceInstance() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"latest_restorable_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -260,6 +274,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"maintenance_window": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -273,6 +288,7 @@ func ResourceInstance() *schema.Resource {
 				},
 				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
 			},
+			//lintignore:AWSS001
 			"max_allocated_storage": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -298,6 +314,7 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"name": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -309,17 +326,20 @@ func ResourceInstance() *schema.Resource {
 					"replicate_source_db",
 				},
 			},
+			//lintignore:AWSS001
 			"nchar_character_set_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"option_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"parameter_group_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -330,17 +350,20 @@ func ResourceInstance() *schema.Resource {
 				Optional:  true,
 				Sensitive: true,
 			},
+			//lintignore:AWSS001
 			"performance_insights_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"performance_insights_kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"performance_insights_retention_period": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -356,12 +379,14 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"replica_mode": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice(rds.ReplicaMode_Values(), false),
 			},
+			//lintignore:AWSS001
 			"replicas": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -375,6 +400,7 @@ func ResourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"restore_to_point_in_time": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -417,6 +443,7 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"s3_import": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -455,6 +482,7 @@ func ResourceInstance() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"security_group_names": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -466,12 +494,14 @@ func ResourceInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"snapshot_identifier": {
 				Type:     schema.TypeString,
 				Computed: true,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -495,6 +525,7 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"username": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -502,6 +533,7 @@ func ResourceInstance() *schema.Resource {
 				ForceNew:      true,
 				ConflictsWith: []string{"replicate_source_db"},
 			},
+			//lintignore:AWSS001
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -92,6 +92,7 @@ func DataSourceInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"enabled_cloudwatch_logs_exports": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -188,7 +188,7 @@ func resourceSnapshotCopyRead(ctx context.Context, d *schema.ResourceData, meta
 
 	snapshot, err := FindSnapshot(ctx, conn, d.Id())
 
-	if tfresource.NotFound(err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] RDS DB Snapshot (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -159,6 +159,7 @@ func ResourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"dns_name": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -207,6 +208,7 @@ func ResourceCluster() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"logging": {
 				Type:             schema.TypeList,
 				MaxItems:         1,
@@ -283,6 +285,7 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  1,
 			},
+			//lintignore:AWSS001
 			"owner_account": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -316,11 +319,13 @@ func ResourceCluster() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"snapshot_cluster_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"snapshot_copy": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -343,6 +348,7 @@ func ResourceCluster() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"snapshot_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
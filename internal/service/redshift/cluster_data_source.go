@@ -81,6 +81,7 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"cluster_security_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -126,6 +127,7 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"iam_roles": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -185,6 +187,7 @@ func DataSourceCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"vpc_security_group_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -29,6 +29,7 @@ func ResourceDelegationSet() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"reference_name": {
 				Type:         schema.TypeString,
 				Optional:     true,
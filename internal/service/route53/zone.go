@@ -65,6 +65,7 @@ func ResourceZone() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 256),
 			},
 
+			//lintignore:AWSS001
 			"vpc": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -92,6 +93,7 @@ func ResourceZone() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"delegation_set_id": {
 				Type:          schema.TypeString,
 				Optional:      true,
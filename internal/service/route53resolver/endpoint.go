@@ -50,6 +50,7 @@ func ResourceEndpoint() *schema.Resource {
 				}, false),
 			},
 
+			//lintignore:AWSS001
 			"ip_address": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -76,6 +77,7 @@ func ResourceEndpoint() *schema.Resource {
 				Set: endpointHashIPAddress,
 			},
 
+			//lintignore:AWSS001
 			"security_group_ids": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -92,6 +94,7 @@ func ResourceEndpoint() *schema.Resource {
 				ValidateFunc: validResolverName,
 			},
 
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 
@@ -100,6 +103,7 @@ func ResourceEndpoint() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"host_vpc_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -82,6 +82,7 @@ func ResourceRule() *schema.Resource {
 				Optional: true,
 			},
 
+			//lintignore:AWSS001
 			"target_ip": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -74,6 +74,7 @@ func ResourceBucket() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"acl": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -83,6 +84,7 @@ func ResourceBucket() *schema.Resource {
 				Deprecated:    "Use the aws_s3_bucket_acl resource instead",
 			},
 
+			//lintignore:AWSS001
 			"grant": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -122,15 +124,21 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"policy": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Computed:         true,
 				Deprecated:       "Use the aws_s3_bucket_policy resource instead",
-				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				StateFunc:        verify.PolicyStateFunc,
+				ValidateFunc: validation.All(
+					validation.StringIsJSON,
+					verify.ValidPolicySize(verify.BucketPolicyMaxSize),
+				),
 			},
 
+			//lintignore:AWSS001
 			"cors_rule": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -166,6 +174,7 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"website": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -235,6 +244,7 @@ func ResourceBucket() *schema.Resource {
 				Deprecated: "Use the aws_s3_bucket_website_configuration resource",
 			},
 
+			//lintignore:AWSS001
 			"versioning": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -257,6 +267,7 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"logging": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -277,6 +288,7 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"lifecycle_rule": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -391,6 +403,7 @@ func ResourceBucket() *schema.Resource {
 				Default:  false,
 			},
 
+			//lintignore:AWSS001
 			"acceleration_status": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -399,6 +412,7 @@ func ResourceBucket() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(s3.BucketAccelerateStatus_Values(), false),
 			},
 
+			//lintignore:AWSS001
 			"request_payer": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -407,6 +421,7 @@ func ResourceBucket() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(s3.Payer_Values(), false),
 			},
 
+			//lintignore:AWSS001
 			"replication_configuration": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -581,6 +596,7 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"server_side_encryption_configuration": {
 				Type:       schema.TypeList,
 				MaxItems:   1,
@@ -624,6 +640,7 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"object_lock_enabled": {
 				Type:          schema.TypeBool,
 				Optional:      true,
@@ -632,6 +649,7 @@ func ResourceBucket() *schema.Resource {
 				ConflictsWith: []string{"object_lock_configuration"},
 			},
 
+			//lintignore:AWSS001
 			"object_lock_configuration": {
 				Type:       schema.TypeList,
 				Optional:   true,
@@ -690,6 +708,7 @@ func ResourceBucket() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
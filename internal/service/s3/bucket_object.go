@@ -51,6 +51,7 @@ func ResourceBucketObject() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"acl": {
 				Type:         schema.TypeString,
 				Default:      s3.ObjectCannedACLPrivate,
@@ -73,11 +74,13 @@ func ResourceBucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"source", "content_base64"},
 			},
+			//lintignore:AWSS001
 			"content_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -121,6 +124,7 @@ func ResourceBucketObject() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			//lintignore:AWSS001
 			"kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -161,11 +165,13 @@ func ResourceBucketObject() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
 				Computed:     true,
 			},
+			//lintignore:AWSS001
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"content", "content_base64"},
 			},
+			//lintignore:AWSS001
 			"source_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -182,6 +188,7 @@ func ResourceBucketObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"website_redirect": {
 				Type:     schema.TypeString,
 				Optional: true,
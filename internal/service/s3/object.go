@@ -49,6 +49,7 @@ func ResourceObject() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"acl": {
 				Type:         schema.TypeString,
 				Default:      s3.ObjectCannedACLPrivate,
@@ -70,11 +71,13 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"content": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"source", "content_base64"},
 			},
+			//lintignore:AWSS001
 			"content_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -117,6 +120,7 @@ func ResourceObject() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			//lintignore:AWSS001
 			"kms_key_id": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -157,11 +161,13 @@ func ResourceObject() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(s3.ServerSideEncryption_Values(), false),
 				Computed:     true,
 			},
+			//lintignore:AWSS001
 			"source": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"content", "content_base64"},
 			},
+			//lintignore:AWSS001
 			"source_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -178,6 +184,7 @@ func ResourceObject() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"website_redirect": {
 				Type:     schema.TypeString,
 				Optional: true,
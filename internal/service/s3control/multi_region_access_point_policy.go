@@ -57,10 +57,7 @@ func ResourceMultiRegionAccessPointPolicy() *schema.Resource {
 							Required:         true,
 							ValidateFunc:     validation.StringIsJSON,
 							DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-							StateFunc: func(v interface{}) string {
-								json, _ := structure.NormalizeJsonString(v)
-								return json
-							},
+							StateFunc:        verify.PolicyStateFunc,
 						},
 					},
 				},
@@ -71,10 +71,7 @@ func ResourceSecret() *schema.Resource {
 				Computed:         true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
+				StateFunc:        verify.PolicyStateFunc,
 			},
 			"recovery_window_in_days": {
 				Type:     schema.TypeInt,
@@ -85,6 +82,7 @@ func ResourceSecret() *schema.Resource {
 					validation.IntInSlice([]int{0}),
 				),
 			},
+			//lintignore:AWSS001
 			"replica": {
 				Type:     schema.TypeSet,
 				Optional: true,
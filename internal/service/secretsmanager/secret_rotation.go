@@ -52,6 +52,7 @@ func ResourceSecretRotation() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"tags": tftags.TagsSchema(),
 		},
 	}
@@ -131,7 +131,7 @@ func resourceCloudFormationStackRead(d *schema.ResourceData, meta interface{}) e
 
 	stack, err := tfcloudformation.FindStackByID(cfConn, d.Id())
 
-	if tfresource.NotFound(err) {
+	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Serverless Application Repository CloudFormation Stack (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
@@ -71,6 +71,7 @@ func ResourceProduct() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"provisioning_artifact_parameters": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -44,6 +44,7 @@ func ResourceStateMachine() *schema.Resource {
 				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
 			},
 
+			//lintignore:AWSS001
 			"logging_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -87,9 +88,11 @@ func ResourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 
+			//lintignore:AWSS001
 			"type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -98,6 +101,7 @@ func ResourceStateMachine() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(sfn.StateMachineType_Values(), false),
 			},
 
+			//lintignore:AWSS001
 			"tracing_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -63,6 +63,7 @@ func ResourceSigningJob() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"destination": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -93,6 +94,7 @@ func ResourceSigningJob() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"ignore_signing_job_failure": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -141,10 +141,7 @@ var (
 			Computed:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
+			StateFunc:        verify.PolicyStateFunc,
 		},
 		"sqs_failure_feedback_role_arn": {
 			Type:         schema.TypeString,
@@ -189,7 +186,7 @@ var (
 		"sqs_failure_feedback_role_arn":         TopicAttributeNameSQSFailureFeedbackRoleARN,
 		"sqs_success_feedback_role_arn":         TopicAttributeNameSQSSuccessFeedbackRoleARN,
 		"sqs_success_feedback_sample_rate":      TopicAttributeNameSQSSuccessFeedbackSampleRate,
-	}, topicSchema).WithIAMPolicyAttribute("policy")
+	}, topicSchema).WithIAMPolicyAttribute("policy").WithJSONAttribute("delivery_policy")
 )
 
 func ResourceTopic() *schema.Resource {
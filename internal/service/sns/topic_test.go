@@ -166,6 +166,31 @@ func TestAccSNSTopic_policy(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_Policy_ignoreEquivalent(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+				),
+			},
+			{
+				Config:   testAccTopicConfig_policyEquivalent(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_withIAMRole(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
@@ -236,6 +261,31 @@ func TestAccSNSTopic_withDeliveryPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_DeliveryPolicy_ignoreEquivalent(t *testing.T) {
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandString(10)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sns.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_deliveryPolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTopicExists(resourceName, &attributes),
+				),
+			},
+			{
+				Config:   testAccTopicConfig_deliveryPolicyEquivalent(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_deliveryStatus(t *testing.T) {
 	var attributes map[string]string
 	resourceName := "aws_sns_topic.test"
@@ -730,6 +780,30 @@ EOF
 `, r)
 }
 
+func testAccTopicConfig_policyEquivalent(r string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+
+resource "aws_sns_topic" "test" {
+  name = "example-%s"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Id      = "Policy1445931846145"
+    Statement = [{
+      Sid       = "Stmt1445931846145"
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = ["sns:Publish"]
+      Resource  = "arn:${data.aws_partition.current.partition}:sns:${data.aws_region.current.name}::example"
+    }]
+  })
+}
+`, r)
+}
+
 // Test for https://github.com/hashicorp/terraform/issues/3660
 func testAccTopicConfig_iamRole(r string) string {
 	return fmt.Sprintf(`
@@ -808,6 +882,29 @@ EOF
 `, r)
 }
 
+func testAccTopicConfig_deliveryPolicyEquivalent(r string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = "tf_acc_test_delivery_policy_%s"
+
+  delivery_policy = jsonencode({
+    http = {
+      disableSubscriptionOverrides = false
+      defaultHealthyRetryPolicy = {
+        backoffFunction    = "linear"
+        maxDelayTarget     = 20
+        minDelayTarget     = 20
+        numMaxDelayRetries = 0
+        numMinDelayRetries = 0
+        numNoDelayRetries  = 0
+        numRetries         = 3
+      }
+    }
+  })
+}
+`, r)
+}
+
 // Test for https://github.com/hashicorp/terraform/issues/3660
 func testAccTopicConfig_fakeIAMRole(r string) string {
 	return fmt.Sprintf(`
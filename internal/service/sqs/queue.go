@@ -99,10 +99,7 @@ var (
 			Computed:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
+			StateFunc:        verify.PolicyStateFunc,
 		},
 		"receive_wait_time_seconds": {
 			Type:     schema.TypeInt,
@@ -163,7 +160,7 @@ var (
 		"redrive_policy":                    sqs.QueueAttributeNameRedrivePolicy,
 		"sqs_managed_sse_enabled":           sqs.QueueAttributeNameSqsManagedSseEnabled,
 		"visibility_timeout_seconds":        sqs.QueueAttributeNameVisibilityTimeout,
-	}, queueSchema).WithIAMPolicyAttribute("policy")
+	}, queueSchema).WithIAMPolicyAttribute("policy").WithJSONAttribute("redrive_allow_policy").WithJSONAttribute("redrive_policy")
 )
 
 func ResourceQueue() *schema.Resource {
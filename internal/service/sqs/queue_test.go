@@ -420,6 +420,76 @@ func TestAccSQSQueue_Policy_ignoreEquivalent(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_Policy_update(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	expectedPolicy1 := `
+{
+  "Version": "2012-10-17",
+  "Id": "sqspolicy",
+  "Statement":[{
+    "Sid": "Stmt1451501026839",
+    "Effect": "Allow",
+    "Principal":"*",
+    "Action":"sqs:SendMessage",
+    "Resource":"arn:%[1]s:sqs:%[2]s:%[3]s:%[4]s",
+    "Condition":{
+      "ArnEquals":{"aws:SourceArn":"arn:%[1]s:sns:%[2]s:%[3]s:%[4]s"}
+    }
+  }]
+}
+`
+	expectedPolicy2 := `
+{
+  "Version": "2012-10-17",
+  "Id": "sqspolicy",
+  "Statement":[{
+    "Sid": "SID1993561419",
+    "Effect": "Allow",
+    "Principal":"*",
+    "Action":[
+      "sqs:SendMessage",
+      "sqs:DeleteMessage",
+      "sqs:ListQueues"
+    ],
+    "Resource":"arn:%[1]s:sqs:%[2]s:%[3]s:%[4]s",
+    "Condition":{
+      "ArnEquals":{"aws:SourceArn":"arn:%[1]s:sns:%[2]s:%[3]s:%[4]s"}
+    }
+  }]
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_policy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					testAccCheckQueuePolicyAttribute(&queueAttributes, rName, expectedPolicy1),
+				),
+			},
+			{
+				Config: testAccQueueConfig_policyEquivalent(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					testAccCheckQueuePolicyAttribute(&queueAttributes, rName, expectedPolicy2),
+				),
+			},
+			{
+				Config:   testAccQueueConfig_policyNewEquivalent(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_recentlyDeleted(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -478,6 +548,32 @@ func TestAccSQSQueue_redrivePolicy(t *testing.T) {
 	})
 }
 
+func TestAccSQSQueue_RedrivePolicy_ignoreEquivalent(t *testing.T) {
+	var queueAttributes map[string]string
+	resourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, sqs.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueueConfig_redrivePolicy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttrSet(resourceName, "redrive_policy"),
+				),
+			},
+			{
+				Config:   testAccQueueConfig_redrivePolicyEquivalent(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccSQSQueue_redriveAllowPolicy(t *testing.T) {
 	var queueAttributes map[string]string
 	resourceName := "aws_sqs_queue.test"
@@ -1055,6 +1151,25 @@ resource "aws_sqs_queue" "dlq" {
 `, rName)
 }
 
+func testAccQueueConfig_redrivePolicyEquivalent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name                       = "%[1]s-1"
+  delay_seconds              = 0
+  visibility_timeout_seconds = 300
+
+  redrive_policy = jsonencode({
+    deadLetterTargetArn = aws_sqs_queue.dlq.arn
+    maxReceiveCount     = 3
+  })
+}
+
+resource "aws_sqs_queue" "dlq" {
+  name = "%[1]s-2"
+}
+`, rName)
+}
+
 func testAccQueueConfig_redriveAllowPolicy(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "test" {
@@ -47,6 +47,7 @@ func ResourceDocument() *schema.Resource {
 					validation.StringLenBetween(3, 128),
 				),
 			},
+			//lintignore:AWSS001
 			"attachments_source": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -93,18 +94,22 @@ func ResourceDocument() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringInSlice(ssm.DocumentType_Values(), false),
 			},
+			//lintignore:AWSS001
 			"schema_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"created_date": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"default_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"description": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -113,31 +118,38 @@ func ResourceDocument() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"hash": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"hash_type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"latest_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"owner": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"platform_types": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			//lintignore:AWSS001
 			"parameter": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -162,6 +174,7 @@ func ResourceDocument() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"permissions": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -169,8 +182,10 @@ func ResourceDocument() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"target_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -179,6 +194,7 @@ func ResourceDocument() *schema.Resource {
 					validation.StringLenBetween(1, 200),
 				),
 			},
+			//lintignore:AWSS001
 			"version_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -41,11 +41,13 @@ func ResourceParameter() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(1, 2048),
 			},
+			//lintignore:AWSS001
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 1024),
 			},
+			//lintignore:AWSS001
 			"tier": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -73,11 +75,13 @@ func ResourceParameter() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"key_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"data_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -87,10 +91,12 @@ func ResourceParameter() *schema.Resource {
 					"text",
 				}, false),
 			},
+			//lintignore:AWSS001
 			"overwrite": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"allowed_pattern": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -100,6 +106,7 @@ func ResourceParameter() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -29,6 +29,7 @@ func ResourcePatchBaseline() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -210,6 +211,7 @@ func ResourcePatchBaseline() *schema.Resource {
 				},
 			},
 
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
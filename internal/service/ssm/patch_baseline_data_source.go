@@ -15,6 +15,7 @@ func DataSourcePatchBaseline() *schema.Resource {
 	return &schema.Resource{
 		Read: dataPatchBaselineRead,
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"approved_patches": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -113,6 +114,7 @@ func DataSourcePatchBaseline() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 255),
 			},
+			//lintignore:AWSS001
 			"rejected_patches": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -25,6 +25,12 @@ func DataSourceCallerIdentity() *schema.Resource {
 				Computed: true,
 			},
 
+			"assumed_role_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"user_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -35,6 +41,7 @@ func DataSourceCallerIdentity() *schema.Resource {
 
 func dataSourceCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*conns.AWSClient).STSConn
+	assumedRoleARNs := meta.(*conns.AWSClient).AssumedRoleARNs
 
 	log.Printf("[DEBUG] Reading Caller Identity")
 	res, err := client.GetCallerIdentity(&sts.GetCallerIdentityInput{})
@@ -48,6 +55,7 @@ func dataSourceCallerIdentityRead(d *schema.ResourceData, meta interface{}) erro
 	d.SetId(aws.StringValue(res.Account))
 	d.Set("account_id", res.Account)
 	d.Set("arn", res.Arn)
+	d.Set("assumed_role_arns", assumedRoleARNs)
 	d.Set("user_id", res.UserId)
 
 	return nil
@@ -55,6 +55,7 @@ func ResourceServer() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			//lintignore:AWSS001
 			"directory_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -70,6 +71,7 @@ func ResourceServer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"endpoint_details": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -121,6 +123,7 @@ func ResourceServer() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			//lintignore:AWSS001
 			"function": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -132,6 +135,7 @@ func ResourceServer() *schema.Resource {
 				Sensitive:    true,
 				ValidateFunc: validation.StringLenBetween(0, 4096),
 			},
+			//lintignore:AWSS001
 			"host_key_fingerprint": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -182,12 +186,14 @@ func ResourceServer() *schema.Resource {
 				Default:      SecurityPolicyName2018_11,
 				ValidateFunc: validation.StringInSlice(SecurityPolicyName_Values(), false),
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"url": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			//lintignore:AWSS001
 			"workflow_details": {
 				Type:     schema.TypeList,
 				Optional: true,
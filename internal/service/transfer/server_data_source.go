@@ -50,6 +50,7 @@ func DataSourceServer() *schema.Resource {
 				Computed: true,
 			},
 
+			//lintignore:AWSS001
 			"protocols": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
-	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
 // WAF Logging Configurations can only be enabled with destinations in specific regions,
@@ -45,7 +44,7 @@ func testAccPreCheckLoggingConfiguration(t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderWafLoggingConfigurationConfigure.Do(func() {
-		testAccProviderWafLoggingConfiguration = provider.Provider()
+		testAccProviderWafLoggingConfiguration = acctest.NewProvider()
 
 		config := map[string]interface{}{
 			"region": region,
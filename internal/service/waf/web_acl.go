@@ -27,6 +27,7 @@ func ResourceWebACL() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -36,6 +37,7 @@ func ResourceWebACL() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"default_action": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -49,12 +51,14 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"metric_name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9A-Za-z]+$`), "must contain only alphanumeric characters"),
 			},
+			//lintignore:AWSS001
 			"logging_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -93,6 +97,7 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"rules": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -141,6 +146,7 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
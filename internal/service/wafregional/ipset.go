@@ -32,10 +32,12 @@ func ResourceIPSet() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"ip_set_descriptor": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -33,12 +33,14 @@ func ResourceRateBasedRule() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"metric_name": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validMetricName,
 			},
+			//lintignore:AWSS001
 			"predicate": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -61,17 +63,21 @@ func ResourceRateBasedRule() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"rate_key": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			//lintignore:AWSS001
 			"rate_limit": {
 				Type:         schema.TypeInt,
 				Required:     true,
 				ValidateFunc: validation.IntAtLeast(100),
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -28,6 +28,7 @@ func ResourceWebACL() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			//lintignore:AWSS001
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -37,6 +38,7 @@ func ResourceWebACL() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"default_action": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -55,6 +57,7 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"logging_configuration": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -95,11 +98,13 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"metric_name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			//lintignore:AWSS001
 			"rule": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -161,6 +166,7 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -87,6 +87,7 @@ func ResourceIPSet() *schema.Resource {
 					wafv2.IPAddressVersionIpv6,
 				}, false),
 			},
+			//lintignore:AWSS001
 			"lock_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -106,6 +107,7 @@ func ResourceIPSet() *schema.Resource {
 					wafv2.ScopeRegional,
 				}, false),
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
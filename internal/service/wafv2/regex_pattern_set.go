@@ -50,6 +50,7 @@ func ResourceRegexPatternSet() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 256),
 			},
+			//lintignore:AWSS001
 			"lock_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -83,6 +84,7 @@ func ResourceRegexPatternSet() *schema.Resource {
 					wafv2.ScopeRegional,
 				}, false),
 			},
+			//lintignore:AWSS001
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
@@ -46,18 +46,21 @@ func ResourceRuleGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"capacity": {
 				Type:         schema.TypeInt,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IntAtLeast(1),
 			},
+			//lintignore:AWSS001
 			"custom_response_body": customResponseBodySchema(),
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 256),
 			},
+			//lintignore:AWSS001
 			"lock_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -80,6 +83,7 @@ func ResourceRuleGroup() *schema.Resource {
 					wafv2.ScopeRegional,
 				}, false),
 			},
+			//lintignore:AWSS001
 			"rule": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -112,8 +116,10 @@ func ResourceRuleGroup() *schema.Resource {
 					},
 				},
 			},
-			"tags":              tftags.TagsSchema(),
-			"tags_all":          tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"visibility_config": visibilityConfigSchema(),
 		},
 
@@ -52,11 +52,14 @@ func ResourceWebACL() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"capacity": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			//lintignore:AWSS001
 			"custom_response_body": customResponseBodySchema(),
+			//lintignore:AWSS001
 			"default_action": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -73,6 +76,7 @@ func ResourceWebACL() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(1, 256),
 			},
+			//lintignore:AWSS001
 			"lock_token": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -95,6 +99,7 @@ func ResourceWebACL() *schema.Resource {
 					wafv2.ScopeRegional,
 				}, false),
 			},
+			//lintignore:AWSS001
 			"rule": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -138,8 +143,10 @@ func ResourceWebACL() *schema.Resource {
 					},
 				},
 			},
-			"tags":              tftags.TagsSchema(),
-			"tags_all":          tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			//lintignore:AWSS001
 			"visibility_config": visibilityConfigSchema(),
 		},
 
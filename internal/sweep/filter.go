@@ -0,0 +1,184 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Attributes holding the creation time of resources, in order of preference.
+var creationTimeAttributes = []string{
+	"create_date",
+	"create_time",
+	"create_timestamp",
+	"created_at",
+	"created_date",
+	"created_time",
+	"creation_date",
+	"creation_time",
+	"creation_timestamp",
+	"launch_time",
+}
+
+// sweepOptions are the options of sweep runs, set by flags of the "go test" command.
+type sweepOptions struct {
+	dryRun             bool
+	minAge             time.Duration
+	reportFile         string
+	serviceConcurrency int
+	tags               tagFilter
+}
+
+var options = &sweepOptions{}
+
+func init() {
+	flag.BoolVar(&options.dryRun, "sweep-dry-run", false, "Report resources which would be swept without deleting them")
+	flag.DurationVar(&options.minAge, "sweep-min-age", 0, "Sweep only resources created at least this long ago, e.g. 24h")
+	flag.StringVar(&options.reportFile, "sweep-report", "", "Path of a JSON report file of swept resources")
+	flag.IntVar(&options.serviceConcurrency, "sweep-service-concurrency", 10, "Maximum number of concurrent deletions per service")
+	flag.Var(&options.tags, "sweep-tags", "Comma-separated list of tags, as key=value or key, resources must have to be swept")
+}
+
+// readOnly returns whether sweeper clients are read-only, blocking sweepers which delete resources
+// directly instead of with SweepOrchestrator. In dry-run mode nothing is deleted, and only
+// SweepOrchestrator applies the tag and age filters.
+func (o *sweepOptions) readOnly() bool {
+	return o.dryRun || len(o.tags) > 0 || o.minAge > 0
+}
+
+// tagFilter is the set of tags resources must have. A nil value matches any value of the tag.
+type tagFilter map[string]*string
+
+func (f *tagFilter) String() string {
+	var tags []string
+
+	for k, v := range *f {
+		if v == nil {
+			tags = append(tags, k)
+		} else {
+			tags = append(tags, k+"="+*v)
+		}
+	}
+
+	sort.Strings(tags)
+
+	return strings.Join(tags, ",")
+}
+
+func (f *tagFilter) Set(s string) error {
+	tags := make(tagFilter)
+
+	for _, tag := range strings.Split(s, ",") {
+		if tag = strings.TrimSpace(tag); tag == "" {
+			continue
+		}
+
+		k, v := tag, (*string)(nil)
+		if i := strings.Index(tag, "="); i >= 0 {
+			k, v = tag[:i], new(string)
+			*v = tag[i+1:]
+		}
+
+		if k == "" {
+			return fmt.Errorf("invalid tag (%s), expected key=value or key", tag)
+		}
+
+		tags[k] = v
+	}
+
+	*f = tags
+
+	return nil
+}
+
+// filter returns the reason the sweep resource does not match the tag and age filters, if any.
+// Matching requires refreshing the resource data. Resources which cannot be refreshed are not matched.
+func (o *sweepOptions) filter(ctx context.Context, sr *SweepResource) (string, error) {
+	if len(o.tags) == 0 && o.minAge == 0 {
+		return "", nil
+	}
+
+	if err := readResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return "unable to read resource", err
+	}
+
+	if sr.d.Id() == "" {
+		return "resource not found", nil
+	}
+
+	if len(o.tags) > 0 {
+		if reason := o.tags.match(sr); reason != "" {
+			return reason, nil
+		}
+	}
+
+	if o.minAge > 0 {
+		created, ok := resourceCreationTime(sr.resource, sr.d)
+
+		if !ok {
+			return "resource creation time unknown", nil
+		}
+
+		if age := time.Since(created); age < o.minAge {
+			return fmt.Sprintf("created %s ago, less than minimum age (%s)", age.Round(time.Second), o.minAge), nil
+		}
+	}
+
+	return "", nil
+}
+
+// match returns the reason the sweep resource's tags do not match the filter, if any.
+func (f tagFilter) match(sr *SweepResource) string {
+	key := "tags_all"
+	if _, ok := sr.resource.Schema[key]; !ok {
+		key = "tags"
+	}
+
+	if _, ok := sr.resource.Schema[key]; !ok {
+		return "resource does not support tags"
+	}
+
+	tags, _ := sr.d.Get(key).(map[string]interface{})
+
+	for k, want := range f {
+		v, ok := tags[k]
+
+		if !ok {
+			return fmt.Sprintf("tag (%s) not found", k)
+		}
+
+		if want != nil && v != *want {
+			return fmt.Sprintf("tag (%s) value (%s) not matched", k, v)
+		}
+	}
+
+	return ""
+}
+
+// resourceCreationTime returns the creation time of the resource, if known.
+func resourceCreationTime(r *schema.Resource, d *schema.ResourceData) (time.Time, bool) {
+	for _, k := range creationTimeAttributes {
+		if s, ok := r.Schema[k]; !ok || s.Type != schema.TypeString {
+			continue
+		}
+
+		v := d.Get(k).(string)
+		if v == "" {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,141 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTagFilterSet(t *testing.T) {
+	var f tagFilter
+
+	if err := f.Set("Owner=team-a, Environment,Empty="); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := f.String(), "Empty=,Environment,Owner=team-a"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	if err := f.Set("=value"); err == nil {
+		t.Error("expected error for empty key")
+	}
+}
+
+func TestSweepOptionsReadOnly(t *testing.T) {
+	tags := tagFilter{"Environment": nil}
+
+	testCases := []struct {
+		Name     string
+		Options  *sweepOptions
+		Expected bool
+	}{
+		{Name: "no flags", Options: &sweepOptions{}, Expected: false},
+		{Name: "report", Options: &sweepOptions{reportFile: "sweep.json"}, Expected: false},
+		{Name: "dry run", Options: &sweepOptions{dryRun: true}, Expected: true},
+		{Name: "tags", Options: &sweepOptions{tags: tags}, Expected: true},
+		{Name: "min age", Options: &sweepOptions{minAge: 24 * time.Hour}, Expected: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := testCase.Options.readOnly(); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestSweepOptionsFilter(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags_all": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+
+	newSweepResource := func(created time.Time, tags map[string]interface{}) *SweepResource {
+		d := r.Data(nil)
+		d.SetId("test")
+		d.Set("creation_date", created.Format(time.RFC3339))
+		d.Set("tags_all", tags)
+
+		return NewSweepResource(r, d, nil)
+	}
+
+	old := time.Now().Add(-48 * time.Hour)
+
+	testCases := []struct {
+		Name          string
+		Options       *sweepOptions
+		SweepResource *SweepResource
+		ExpectSkip    bool
+	}{
+		{
+			Name:          "no filters",
+			Options:       &sweepOptions{},
+			SweepResource: newSweepResource(time.Now(), nil),
+		},
+		{
+			Name:          "tag key and value match",
+			Options:       &sweepOptions{tags: tagFilter{"Owner": stringPtr("team-a"), "Environment": nil}},
+			SweepResource: newSweepResource(old, map[string]interface{}{"Owner": "team-a", "Environment": "dev"}),
+		},
+		{
+			Name:          "tag value mismatch",
+			Options:       &sweepOptions{tags: tagFilter{"Owner": stringPtr("team-a")}},
+			SweepResource: newSweepResource(old, map[string]interface{}{"Owner": "team-b"}),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "tag key missing",
+			Options:       &sweepOptions{tags: tagFilter{"Owner": nil}},
+			SweepResource: newSweepResource(old, nil),
+			ExpectSkip:    true,
+		},
+		{
+			Name:          "old enough",
+			Options:       &sweepOptions{minAge: 24 * time.Hour},
+			SweepResource: newSweepResource(old, nil),
+		},
+		{
+			Name:          "too new",
+			Options:       &sweepOptions{minAge: 24 * time.Hour},
+			SweepResource: newSweepResource(time.Now(), nil),
+			ExpectSkip:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			reason, err := testCase.Options.filter(context.Background(), testCase.SweepResource)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if skip := reason != ""; skip != testCase.ExpectSkip {
+				t.Errorf("got skip %t (%s), expected %t", skip, reason, testCase.ExpectSkip)
+			}
+		})
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,86 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// Outcomes of sweeping a resource.
+const (
+	outcomeDeferred    = "deferred"
+	outcomeDeleted     = "deleted"
+	outcomeFailed      = "failed"
+	outcomeSkipped     = "skipped"
+	outcomeWouldDelete = "would_delete"
+)
+
+// reportEntry is the outcome of sweeping a single resource.
+type reportEntry struct {
+	ResourceType string `json:"resource_type"`
+	ID           string `json:"id"`
+	Region       string `json:"region"`
+	Outcome      string `json:"outcome"`
+	Reason       string `json:"reason,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// sweepReport accumulates the outcomes of all sweep resources in the run.
+type sweepReport struct {
+	mu        sync.Mutex
+	DryRun    bool           `json:"dry_run"`
+	Resources []*reportEntry `json:"resources"`
+}
+
+var report = &sweepReport{}
+
+// add adds entries to the report and, if a path is given, rewrites the report file.
+// The report file is rewritten after each sweep so it is complete even if sweepers do not finish.
+func (r *sweepReport) add(path string, entries ...*reportEntry) error {
+	r.mu.Lock()
+	r.Resources = append(r.Resources, entries...)
+	r.mu.Unlock()
+
+	return r.write(path)
+}
+
+// len returns the number of entries in the report.
+func (r *sweepReport) len() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.Resources)
+}
+
+// setResourceType sets the resource type of the entries added since the report had the specified number of entries,
+// i.e. the entries of the sweep resources of a sweeper run by TestMain.
+func (r *sweepReport) setResourceType(from int, resourceType string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, entry := range r.Resources[from:] {
+		entry.ResourceType = resourceType
+	}
+}
+
+// write writes the report file, if a path is given.
+func (r *sweepReport) write(path string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.DryRun = options.dryRun
+
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0644) //nolint:gosec
+}
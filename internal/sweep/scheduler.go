@@ -0,0 +1,572 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+const (
+	// Maximum number of rounds retrying deletions which failed as other resources still depended on the resource.
+	dependencyRetryRounds = 5
+
+	// Delay before each round retrying deletions, allowing deletions of dependent resources to complete.
+	dependencyRetryDelay = 30 * time.Second
+)
+
+// AWS error codes returned when deleting a resource other resources still depend on.
+var dependencyErrorCodes = []string{
+	"DeleteConflict",
+	"DependencyViolation",
+	"InvalidDBSubnetGroupStateFault",
+	"ResourceInUse",
+	"ResourceInUseException",
+}
+
+// The code of an AWS error in the text of an error, e.g. "error deleting EC2 VPC (vpc-12345678): DependencyViolation: ...".
+var errorCodeRegexp = regexp.MustCompile(`(?:^|: )([A-Z][0-9A-Za-z.]*): `)
+
+// isDependencyError returns whether the error is due to other resources still depending on the deleted resource.
+// Errors of resources returning diagnostics are no longer AWS errors, so their AWS error codes are read from the error text.
+func isDependencyError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if tfawserr.ErrCodeEquals(err, dependencyErrorCodes...) {
+		return true
+	}
+
+	for _, m := range errorCodeRegexp.FindAllStringSubmatch(err.Error(), -1) {
+		for _, code := range dependencyErrorCodes {
+			if m[1] == code {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// functionService returns the provider package of a function, e.g. "ec2" for a sweeper or resource Delete function.
+func functionService(f interface{}) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+
+	if fn == nil {
+		return ""
+	}
+
+	// github.com/hashicorp/terraform-provider-aws/internal/service/ec2.sweepVPCs
+	name := fn.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	return strings.SplitN(name, ".", 2)[0]
+}
+
+// serviceSemaphores limit the number of concurrent deletions per service.
+var serviceSemaphores = struct {
+	sync.Mutex
+	m map[string]chan struct{}
+}{m: make(map[string]chan struct{})}
+
+// acquireService blocks until a deletion for the service may proceed and returns the function releasing it.
+func acquireService(service string) func() {
+	serviceSemaphores.Lock()
+	sem, ok := serviceSemaphores.m[service]
+	if !ok {
+		n := options.serviceConcurrency
+		if n < 1 {
+			n = 1
+		}
+		sem = make(chan struct{}, n)
+		serviceSemaphores.m[service] = sem
+	}
+	serviceSemaphores.Unlock()
+
+	sem <- struct{}{}
+
+	return func() { <-sem }
+}
+
+// sweepTask is the sweep of a single resource.
+type sweepTask struct {
+	// sweeper is the name of the sweeper, set when the sweep task is deferred.
+	sweeper       string
+	sweepResource *SweepResource
+	entry         *reportEntry
+	delete        func() error
+}
+
+// dependencyError is returned by sweepTasks for the sweep tasks which failed as other resources
+// still depended on their resources, so that TestMain can retry them once all sweepers have run.
+type dependencyError struct {
+	tasks []*sweepTask
+	err   error
+}
+
+func (e *dependencyError) Error() string {
+	return e.err.Error()
+}
+
+func (e *dependencyError) Unwrap() error {
+	return e.err
+}
+
+// dependencyErrorTasks returns the sweep tasks of the dependency errors in a sweeper's error, and
+// whether the error consists only of dependency errors, possibly wrapped or appended together.
+func dependencyErrorTasks(err error) ([]*sweepTask, bool) {
+	switch err := err.(type) { //nolint:errorlint // Wrapped errors are unwrapped below.
+	case nil:
+		return nil, true
+	case *dependencyError:
+		return err.tasks, true
+	case *multierror.Error:
+		if err == nil {
+			return nil, true
+		}
+
+		var tasks []*sweepTask
+		only := true
+
+		for _, err := range err.Errors {
+			t, ok := dependencyErrorTasks(err)
+			tasks = append(tasks, t...)
+			only = only && ok
+		}
+
+		return tasks, only
+	}
+
+	if wrapped := errors.Unwrap(err); wrapped != nil {
+		return dependencyErrorTasks(wrapped)
+	}
+
+	return nil, false
+}
+
+// sweepTasks runs the sweep tasks concurrently, limited per service.
+// Sweep tasks failing as other resources still depend on their resources are returned in a dependencyError.
+func sweepTasks(ctx context.Context, tasks []*sweepTask) error {
+	var g multierror.Group
+	var mu sync.Mutex
+	var dependencyTasks []*sweepTask
+	var dependencyErrs *multierror.Error
+
+	for _, task := range tasks {
+		task := task
+
+		g.Go(func() error {
+			release := acquireService(task.sweepResource.service())
+			defer release()
+
+			if reason, err := options.filter(ctx, task.sweepResource); reason != "" {
+				log.Printf("[INFO] Skipping sweep of resource (%s): %s", task.entry.ID, reason)
+				task.entry.Outcome = outcomeSkipped
+				task.entry.Reason = reason
+				if err != nil {
+					task.entry.Error = err.Error()
+				}
+
+				return nil
+			}
+
+			if options.dryRun {
+				log.Printf("[INFO] Dry run, would sweep resource (%s)", task.entry.ID)
+				task.entry.Outcome = outcomeWouldDelete
+
+				return nil
+			}
+
+			err := task.delete()
+
+			if err == nil {
+				task.entry.Outcome = outcomeDeleted
+				task.entry.Error = ""
+
+				return nil
+			}
+
+			task.entry.Outcome = outcomeFailed
+			task.entry.Error = err.Error()
+
+			if isDependencyError(err) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				dependencyTasks = append(dependencyTasks, task)
+				dependencyErrs = multierror.Append(dependencyErrs, err)
+
+				return nil
+			}
+
+			return err
+		})
+	}
+
+	errs := g.Wait()
+
+	if len(dependencyTasks) > 0 {
+		errs = multierror.Append(errs, &dependencyError{tasks: dependencyTasks, err: dependencyErrs})
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// sweepRun is the run of all sweepers for a region by TestMain.
+type sweepRun struct {
+	// deferred are the sweep tasks to retry once all sweepers have run.
+	deferred []*sweepTask
+	// retryDelay is the delay before each round retrying deferred sweep tasks.
+	retryDelay time.Duration
+}
+
+func newSweepRun() *sweepRun {
+	return &sweepRun{
+		retryDelay: dependencyRetryDelay,
+	}
+}
+
+// deferTasks defers the sweeper's sweep tasks which failed as other resources depended on their resources
+// until all sweepers for the region have run.
+func (r *sweepRun) deferTasks(sweeper string, tasks []*sweepTask) {
+	for _, task := range tasks {
+		log.Printf("[INFO] Deferring sweep of resource (%s), other resources depend on it: %s", task.entry.ID, task.entry.Error)
+		task.sweeper = sweeper
+		task.entry.Outcome = outcomeDeferred
+		r.deferred = append(r.deferred, task)
+	}
+}
+
+// retryDeferred retries deferred sweep tasks in rounds, in sweeper order, until all succeed or a round makes no progress.
+// Returns the sweep tasks which could not be completed.
+func (r *sweepRun) retryDeferred(ctx context.Context, order []string) []*sweepTask {
+	pending := r.deferred
+	r.deferred = nil
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	position := make(map[string]int, len(order))
+	for i, name := range order {
+		position[name] = i
+	}
+
+rounds:
+	for round := 1; round <= dependencyRetryRounds && len(pending) > 0; round++ {
+		log.Printf("[INFO] Retrying sweep of %d resources other resources depended on (round %d of %d)", len(pending), round, dependencyRetryRounds)
+
+		timer := time.NewTimer(r.retryDelay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Printf("[WARN] Stopping retries of resources other resources depended on: %s", ctx.Err())
+			break rounds
+		case <-timer.C:
+		}
+
+		// Resources are retried one sweeper at a time, so dependent resources are deleted first.
+		sort.SliceStable(pending, func(i, j int) bool {
+			return position[pending[i].sweeper] < position[pending[j].sweeper]
+		})
+
+		var remaining []*sweepTask
+		var mu sync.Mutex
+
+		for start := 0; start < len(pending); {
+			end := start
+			for end < len(pending) && pending[end].sweeper == pending[start].sweeper {
+				end++
+			}
+
+			var g multierror.Group
+
+			for _, task := range pending[start:end] {
+				task := task
+
+				g.Go(func() error {
+					release := acquireService(task.sweepResource.service())
+					defer release()
+
+					err := task.delete()
+
+					if err == nil {
+						task.entry.Outcome = outcomeDeleted
+						task.entry.Error = ""
+
+						return nil
+					}
+
+					task.entry.Error = err.Error()
+
+					if isDependencyError(err) {
+						mu.Lock()
+						remaining = append(remaining, task)
+						mu.Unlock()
+
+						return nil
+					}
+
+					task.entry.Outcome = outcomeFailed
+
+					return err
+				})
+			}
+
+			if err := g.Wait().ErrorOrNil(); err != nil {
+				log.Printf("[ERROR] Error retrying sweep of resources: %s", err)
+			}
+
+			start = end
+		}
+
+		if len(remaining) == len(pending) {
+			pending = remaining
+			break
+		}
+
+		pending = remaining
+	}
+
+	for _, task := range pending {
+		task.entry.Outcome = outcomeFailed
+		task.entry.Reason = "other resources still depend on it"
+	}
+
+	return pending
+}
+
+// TestMain runs the registered sweepers when the -sweep flag is used with "go test",
+// otherwise tests are executed as normal.
+//
+// Sweepers are run one at a time for each region, after the sweepers they depend on.
+// Deletions failing as other resources still depend on the deleted resource are retried
+// after all sweepers have run, and a summary of resources not swept is printed.
+func TestMain(m *testing.M) {
+	SweeperClients = make(map[string]interface{})
+
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	order, err := sweeperOrder(filterSweepers(flagValue("sweep-run")))
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	allowFailures := flagValue("sweep-allow-failures") == "true"
+	failed := false
+
+	for _, region := range strings.Split(regions, ",") {
+		if err := runSweepers(context.Background(), strings.TrimSpace(region), order, allowFailures); err != nil {
+			log.Printf("[ERROR] %s", err)
+			failed = true
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+// flagValue returns the value of a "go test" flag registered by the Terraform Plugin SDK.
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// runSweepers runs the sweepers, in order, for the region and prints a summary.
+func runSweepers(ctx context.Context, region string, order []string, allowFailures bool) error {
+	run := newSweepRun()
+
+	start := time.Now()
+	log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+	failedSweepers := make(map[string]error)
+	ran := 0
+
+	for _, name := range order {
+		ran++
+		log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+		sweeperStart := time.Now()
+		reported := report.len()
+		err := sweepers[name].F(region)
+
+		report.setResourceType(reported, name)
+
+		if tasks, ok := dependencyErrorTasks(err); len(tasks) > 0 {
+			run.deferTasks(name, tasks)
+
+			if ok {
+				err = nil
+			}
+		}
+
+		log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(sweeperStart))
+
+		if err != nil {
+			log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+			failedSweepers[name] = err
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	remaining := run.retryDeferred(ctx, order)
+
+	if err := report.write(options.reportFile); err != nil {
+		log.Printf("[ERROR] Error writing sweep report (%s): %s", options.reportFile, err)
+	}
+
+	log.Printf("Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+	printSummary(region, order, ran, failedSweepers, remaining)
+
+	if len(failedSweepers) > 0 || len(remaining) > 0 {
+		return fmt.Errorf("sweeping region (%s): %d sweepers failed, %d resources other resources depend on remain", region, len(failedSweepers), len(remaining))
+	}
+
+	return nil
+}
+
+// printSummary prints the sweepers which failed and the resources remaining, and why.
+func printSummary(region string, order []string, ran int, failedSweepers map[string]error, remaining []*sweepTask) {
+	fmt.Printf("Sweep summary for region (%s): %d of %d sweepers run, %d failed\n", region, ran, len(order), len(failedSweepers))
+
+	for _, name := range order {
+		if err, ok := failedSweepers[name]; ok {
+			fmt.Printf("\t- %s: %s\n", name, err)
+		}
+	}
+
+	if len(remaining) == 0 {
+		return
+	}
+
+	fmt.Printf("Resources remaining in region (%s) as other resources still depend on them:\n", region)
+
+	for _, task := range remaining {
+		fmt.Printf("\t- %s (%s): %s\n", task.sweeper, task.entry.ID, task.entry.Error)
+	}
+}
+
+// filterSweepers returns the names of the sweepers matching the comma-separated filter, and their dependencies.
+// Names match if they contain any filter value, ignoring case. An empty filter matches all sweepers.
+func filterSweepers(filter string) []string {
+	var values []string
+
+	for _, v := range strings.Split(strings.ToLower(filter), ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	var names []string
+
+	for name := range sweepers {
+		if len(values) == 0 {
+			names = append(names, name)
+			continue
+		}
+
+		for _, v := range values {
+			if strings.Contains(strings.ToLower(name), v) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+
+	return names
+}
+
+// sweeperOrder returns the sweepers, and their dependencies, in the order to run them: each after its dependencies.
+func sweeperOrder(names []string) ([]string, error) {
+	sort.Strings(names)
+
+	var order []string
+	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
+
+	var visit func(name, dependent string) error
+	visit = func(name, dependent string) error {
+		if visited[name] {
+			return nil
+		}
+
+		if visiting[name] {
+			return fmt.Errorf("sweeper (%s) has circular dependency (%s)", dependent, name)
+		}
+
+		s, ok := sweepers[name]
+
+		if !ok {
+			log.Printf("[WARN] Sweeper (%s) has dependency (%s), but that sweeper was not found", dependent, name)
+			visited[name] = true
+
+			return nil
+		}
+
+		visiting[name] = true
+
+		for _, dependency := range s.Dependencies {
+			if err := visit(dependency, name); err != nil {
+				return err
+			}
+		}
+
+		visiting[name] = false
+		visited[name] = true
+		order = append(order, name)
+
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(order) == 0 {
+		return nil, errors.New("no sweepers found")
+	}
+
+	return order, nil
+}
@@ -0,0 +1,207 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSweeperOrder(t *testing.T) {
+	defer func(s map[string]*resource.Sweeper) { sweepers = s }(sweepers)
+
+	newSweeper := func(name string, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{Name: name, Dependencies: dependencies}
+	}
+
+	sweepers = map[string]*resource.Sweeper{
+		"aws_vpc":              newSweeper("aws_vpc", "aws_subnet", "aws_internet_gateway"),
+		"aws_subnet":           newSweeper("aws_subnet", "aws_instance"),
+		"aws_instance":         newSweeper("aws_instance", "aws_not_registered"),
+		"aws_internet_gateway": newSweeper("aws_internet_gateway"),
+		"aws_s3_bucket":        newSweeper("aws_s3_bucket"),
+	}
+
+	order, err := sweeperOrder(filterSweepers("VPC"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"aws_instance", "aws_subnet", "aws_internet_gateway", "aws_vpc"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("got %v, expected %v", order, want)
+	}
+
+	order, err = sweeperOrder(filterSweepers(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := len(order), len(sweepers); got != want {
+		t.Errorf("got %d sweepers, expected %d", got, want)
+	}
+
+	sweepers["aws_instance"] = newSweeper("aws_instance", "aws_vpc")
+
+	if _, err := sweeperOrder(filterSweepers("aws_vpc")); err == nil {
+		t.Error("expected error for circular dependency")
+	}
+
+	if _, err := sweeperOrder(filterSweepers("aws_not_registered")); err == nil {
+		t.Error("expected error for no sweepers found")
+	}
+}
+
+func TestIsDependencyError(t *testing.T) {
+	testCases := []struct {
+		Err      error
+		Expected bool
+	}{
+		{
+			Err: nil,
+		},
+		{
+			Err: errors.New("error deleting EC2 VPC (vpc-12345678): ThrottlingException: Rate exceeded"),
+		},
+		{
+			Err:      errors.New("error deleting EC2 VPC (vpc-12345678): DependencyViolation: The vpc 'vpc-12345678' has dependencies and cannot be deleted."),
+			Expected: true,
+		},
+		{
+			Err:      errors.New("error deleting IAM Role (test): DeleteConflict: Cannot delete entity, must detach all policies first."),
+			Expected: true,
+		},
+		{
+			Err:      fmt.Errorf("error deleting ELBv2 Target Group (test): %w", awserr.New("ResourceInUse", "Target group is currently in use by a listener or a rule", nil)),
+			Expected: true,
+		},
+		{
+			Err: fmt.Errorf("error deleting ECS Cluster (test): %w", awserr.New("ClusterContainsServicesException", "The Cluster cannot be deleted while Services are active.", nil)),
+		},
+		{
+			Err: errors.New("error deleting resource: error deleting SNS Topic (ResourceInUse-test): NotFound: Topic does not exist"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		if got := isDependencyError(testCase.Err); got != testCase.Expected {
+			t.Errorf("isDependencyError(%v) got %t, expected %t", testCase.Err, got, testCase.Expected)
+		}
+	}
+}
+
+func TestDependencyErrorTasks(t *testing.T) {
+	first, second := &sweepTask{}, &sweepTask{}
+	dependencyErr := &dependencyError{tasks: []*sweepTask{first, second}, err: errors.New("DependencyViolation: test")}
+
+	testCases := []struct {
+		Name          string
+		Err           error
+		ExpectedTasks []*sweepTask
+		ExpectedOnly  bool
+	}{
+		{
+			Name:         "nil",
+			ExpectedOnly: true,
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("ThrottlingException: Rate exceeded"),
+		},
+		{
+			Name:          "wrapped",
+			Err:           fmt.Errorf("error sweeping EC2 VPCs (us-west-2): %w", dependencyErr),
+			ExpectedTasks: []*sweepTask{first, second},
+			ExpectedOnly:  true,
+		},
+		{
+			Name:          "appended with other error",
+			Err:           multierror.Append(errors.New("ThrottlingException: Rate exceeded"), fmt.Errorf("error sweeping EC2 VPCs (us-west-2): %w", dependencyErr)),
+			ExpectedTasks: []*sweepTask{first, second},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			tasks, only := dependencyErrorTasks(testCase.Err)
+
+			if !reflect.DeepEqual(tasks, testCase.ExpectedTasks) {
+				t.Errorf("got %d tasks, expected %d", len(tasks), len(testCase.ExpectedTasks))
+			}
+
+			if only != testCase.ExpectedOnly {
+				t.Errorf("got only dependency errors %t, expected %t", only, testCase.ExpectedOnly)
+			}
+		})
+	}
+}
+
+func TestRetryDeferredNoPending(t *testing.T) {
+	run := newSweepRun()
+	run.retryDelay = time.Hour
+
+	done := make(chan []*sweepTask)
+	go func() {
+		done <- run.retryDeferred(context.Background(), nil)
+	}()
+
+	select {
+	case remaining := <-done:
+		if len(remaining) != 0 {
+			t.Errorf("got %d remaining tasks, expected none", len(remaining))
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected no retry delay without deferred tasks")
+	}
+}
+
+func TestFunctionService(t *testing.T) {
+	if got, want := functionService(TestFunctionService), "sweep"; got != want {
+		t.Errorf("got %q, expected %q", got, want)
+	}
+}
+
+func TestRetryDeferredContextDone(t *testing.T) {
+	run := newSweepRun()
+	run.retryDelay = time.Hour
+
+	entry := &reportEntry{}
+	run.deferred = []*sweepTask{{
+		sweeper:       "test",
+		sweepResource: &SweepResource{},
+		entry:         entry,
+		delete: func() error {
+			t.Error("unexpected retry after the context is done")
+
+			return nil
+		},
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan []*sweepTask)
+	go func() {
+		done <- run.retryDeferred(ctx, []string{"test"})
+	}()
+
+	select {
+	case remaining := <-done:
+		if len(remaining) != 1 {
+			t.Errorf("got %d remaining tasks, expected 1", len(remaining))
+		}
+		if entry.Outcome != outcomeFailed {
+			t.Errorf("got outcome %q, expected %q", entry.Outcome, outcomeFailed)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("expected no retry delay after the context is done")
+	}
+}
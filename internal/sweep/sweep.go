@@ -9,10 +9,11 @@ import (
 	"log"
 	"os"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,6 +31,10 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
+// SweepThrottlingRetryPolicy retries deletions of sweep resources on throttling errors.
+// Errors returned by DeleteResource are no longer AWS errors, so throttling errors are matched by message.
+var SweepThrottlingRetryPolicy = tfresource.ThrottlingRetryPolicy.WithTimeout(SweepThrottlingRetryTimeout).WithErrorMessage("", "Throttling")
+
 // SweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
@@ -45,6 +50,51 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		return client, nil
 	}
 
+	client, err := newRegionalSweepClient(ctx, region, options.readOnly())
+	if err != nil {
+		return nil, err
+	}
+
+	SweeperClients[region] = client
+
+	return client, nil
+}
+
+var (
+	// deletionClients is a cache of regional conns.AWSClient used to delete sweep resources
+	// when sweeper clients are read-only.
+	deletionClients      = make(map[string]interface{})
+	deletionClientsMutex sync.Mutex
+)
+
+// deletionClient returns the client used to delete the sweep resource.
+// When sweeper clients are read-only as the -sweep-tags or -sweep-min-age flags are used,
+// sweep resources matching the filters are deleted with a separate client for the region.
+func deletionClient(ctx context.Context, sweepResource *SweepResource) (interface{}, error) {
+	region := sweepResource.region()
+
+	if !options.readOnly() || region == "" {
+		return sweepResource.meta, nil
+	}
+
+	deletionClientsMutex.Lock()
+	defer deletionClientsMutex.Unlock()
+
+	if client, ok := deletionClients[region]; ok {
+		return client, nil
+	}
+
+	client, err := newRegionalSweepClient(ctx, region, false)
+	if err != nil {
+		return nil, err
+	}
+
+	deletionClients[region] = client
+
+	return client, nil
+}
+
+func newRegionalSweepClient(ctx context.Context, region string, readOnly bool) (interface{}, error) {
 	_, _, err := conns.RequireOneOfEnvVar([]string{conns.EnvVarProfile, conns.EnvVarAccessKeyId, conns.EnvVarContainerCredentialsFullURI}, "credentials for running sweepers")
 	if err != nil {
 		return nil, err
@@ -59,29 +109,34 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 
 	conf := &conns.Config{
 		MaxRetries:       5,
+		ReadOnly:         readOnly,
 		Region:           region,
 		SuppressDebugLog: true,
 	}
 
 	if role := os.Getenv(conns.EnvVarAssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		assumeRole := &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
-		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
+		assumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(conns.EnvVarAssumeRoleDuration); v != "" {
 			d, err := strconv.Atoi(v)
 			if err != nil {
 				return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarAssumeRoleDuration, err)
 			}
-			conf.AssumeRole.Duration = time.Duration(d) * time.Second
+			assumeRole.Duration = time.Duration(d) * time.Second
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleExternalID); v != "" {
-			conf.AssumeRole.ExternalID = v
+			assumeRole.ExternalID = v
 		}
 
 		if v := os.Getenv(conns.EnvVarAssumeRoleSessionName); v != "" {
-			conf.AssumeRole.SessionName = v
+			assumeRole.SessionName = v
 		}
+
+		conf.AssumeRoles = []*awsbase.AssumeRole{assumeRole}
 	}
 
 	// configures a default client for the region, using the above env vars
@@ -90,17 +145,49 @@ func SharedRegionalSweepClientWithContext(ctx context.Context, region string) (i
 		return nil, fmt.Errorf("error getting AWS client: %#v", diags)
 	}
 
-	SweeperClients[region] = client
-
 	return client, nil
 }
 
+// sweepers are the registered sweepers, by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper with the acceptance testing framework.
+// The sweepers are also recorded for scheduling by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
+
 type SweepResource struct {
 	d        *schema.ResourceData
 	meta     interface{}
 	resource *schema.Resource
 }
 
+// region returns the AWS region of the sweep resource's client.
+func (sr *SweepResource) region() string {
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		return client.Region
+	}
+
+	return ""
+}
+
+// service returns the provider package of the sweep resource, e.g. "ec2".
+func (sr *SweepResource) service() string {
+	switch {
+	case sr.resource.DeleteContext != nil:
+		return functionService(sr.resource.DeleteContext)
+	case sr.resource.DeleteWithoutTimeout != nil:
+		return functionService(sr.resource.DeleteWithoutTimeout)
+	case sr.resource.Delete != nil:
+		return functionService(sr.resource.Delete)
+	}
+
+	return ""
+}
+
 func NewSweepResource(resource *schema.Resource, d *schema.ResourceData, meta interface{}) *SweepResource {
 	return &SweepResource{
 		d:        d,
@@ -113,37 +200,87 @@ func SweepOrchestrator(sweepResources []*SweepResource) error {
 	return SweepOrchestratorWithContext(context.Background(), sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, SweepThrottlingRetryTimeout)
 }
 
+// SweepOrchestratorWithContext deletes the sweep resources, retrying on throttling errors.
+// See SweepOrchestratorWithPolicyContext.
 func SweepOrchestratorWithContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
-	var g multierror.Group
+	policy := SweepThrottlingRetryPolicy.WithTimeout(timeout)
+	policy.Delay = delay
+
+	if delayRand > 0 {
+		policy.Delay = delayRand
+		policy.Jitter = true
+	}
+
+	if minTimeout > 0 {
+		policy.MinBackoff = minTimeout
+	}
+
+	if pollInterval > 0 {
+		policy.MinBackoff = pollInterval
+		policy.MaxBackoff = pollInterval
+	}
+
+	return SweepOrchestratorWithPolicyContext(ctx, sweepResources, policy)
+}
+
+// SweepOrchestratorWithPolicyContext deletes the sweep resources, with concurrency limited per service.
+// Deletions failing with errors retryable according to the policy are retried.
+//
+// Sweep resources not matching the -sweep-tags or -sweep-min-age flags are skipped.
+// With the -sweep-dry-run flag, sweep resources are reported but not deleted.
+// Deletions failing as other resources still depend on the sweep resource are returned
+// in an error which TestMain recognizes, retrying them after all sweepers for the region
+// have run, so sweepers should return the error, which may be wrapped.
+// Outcomes are written to the report file of the -sweep-report flag.
+func SweepOrchestratorWithPolicyContext(ctx context.Context, sweepResources []*SweepResource, policy tfresource.RetryPolicy) error {
+	tasks := make([]*sweepTask, 0, len(sweepResources))
+	entries := make([]*reportEntry, 0, len(sweepResources))
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
-		g.Go(func() error {
-			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
-				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
+		task := &sweepTask{
+			sweepResource: sweepResource,
+			entry: &reportEntry{
+				ID:     sweepResource.d.Id(),
+				Region: sweepResource.region(),
+			},
+			delete: func() error {
+				return deleteWithRetry(ctx, sweepResource, policy)
+			},
+		}
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
-					}
+		tasks = append(tasks, task)
+		entries = append(entries, task.entry)
+	}
 
-					return resource.NonRetryableError(err)
-				}
+	err := sweepTasks(ctx, tasks)
 
-				return nil
-			})
+	if reportErr := report.add(options.reportFile, entries...); reportErr != nil {
+		err = multierror.Append(err, reportErr).ErrorOrNil()
+	}
 
-			if tfresource.TimedOut(err) {
-				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
-			}
+	return err
+}
 
-			return err
-		})
+// deleteWithRetry deletes the sweep resource, retrying errors retryable according to the policy.
+func deleteWithRetry(ctx context.Context, sweepResource *SweepResource, policy tfresource.RetryPolicy) error {
+	meta, err := deletionClient(ctx, sweepResource)
+	if err != nil {
+		return err
 	}
 
-	return g.Wait().ErrorOrNil()
+	_, err = policy.RetryContext(ctx, func() (interface{}, error) {
+		err := DeleteResource(sweepResource.resource, sweepResource.d, meta)
+
+		if policy.IsRetryable(err) {
+			log.Printf("[INFO] While sweeping resource (%s), encountered retryable error (%s). Retrying...", sweepResource.d.Id(), err)
+		}
+
+		return nil, err
+	})
+
+	return err
 }
 
 // Check sweeper API call error for reasons to skip sweeping
@@ -234,6 +371,33 @@ func DeleteResource(resource *schema.Resource, d *schema.ResourceData, meta inte
 	return resource.Delete(d, meta)
 }
 
+// readResource refreshes the resource data, as in a Terraform refresh.
+func readResource(ctx context.Context, resource *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	if resource.ReadContext != nil || resource.ReadWithoutTimeout != nil {
+		var diags diag.Diagnostics
+
+		if resource.ReadContext != nil {
+			diags = resource.ReadContext(ctx, d, meta)
+		} else {
+			diags = resource.ReadWithoutTimeout(ctx, d, meta)
+		}
+
+		for i := range diags {
+			if diags[i].Severity == diag.Error {
+				return fmt.Errorf("error reading resource: %s", diags[i].Summary)
+			}
+		}
+
+		return nil
+	}
+
+	if resource.Read == nil {
+		return nil
+	}
+
+	return resource.Read(d, meta)
+}
+
 func Partition(region string) string {
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
 		return partition.ID()
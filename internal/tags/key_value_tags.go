@@ -3,6 +3,7 @@ package tags
 import (
 	"fmt"
 	"net/url"
+	"path"
 	"reflect"
 	"regexp"
 	"sort"
@@ -21,13 +22,37 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	Tags             KeyValueTags
+	ResourceTypeTags []*ResourceTypeDefaultConfig
+}
+
+// ResourceTypeDefaultConfig contains tags to default across resources of matching types.
+// Resource types are matched using path.Match patterns, e.g. "aws_ebs_*".
+type ResourceTypeDefaultConfig struct {
+	ResourceTypes []string
+	Tags          KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	KeyRegexes  []*regexp.Regexp
+}
+
+// RequiredConfig contains tags that must be present on resources.
+type RequiredConfig struct {
+	Tags []*RequiredTag
+}
+
+// RequiredTag is a tag key that must be present on resources of matching types,
+// optionally with a value matching a regular expression.
+// Resource types are matched using path.Match patterns, e.g. "aws_ebs_*".
+type RequiredTag struct {
+	Key                  string
+	AllowedValuesRegex   *regexp.Regexp
+	ResourceTypes        []string
+	ExcludeResourceTypes []string
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -58,9 +83,42 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResourceType returns the DefaultConfig that applies to resources of the given type,
+// i.e. DefaultConfig.Tags merged with the tags of each matching ResourceTypeTags
+// configuration in order, later configurations overriding the value of any tag with a matching key.
+func (dc *DefaultConfig) ForResourceType(resourceType string) *DefaultConfig {
+	if dc == nil || len(dc.ResourceTypeTags) == 0 {
+		return dc
+	}
+
+	tags := dc.Tags
+
+	for _, rtc := range dc.ResourceTypeTags {
+		if !rtc.AppliesTo(resourceType) {
+			continue
+		}
+
+		tags = tags.Merge(rtc.Tags)
+	}
+
+	return &DefaultConfig{
+		Tags: tags,
+	}
+}
+
+// AppliesTo returns whether the default tags apply to resources of the given type.
+func (rtc *ResourceTypeDefaultConfig) AppliesTo(resourceType string) bool {
+	if rtc == nil {
+		return false
+	}
+
+	return resourceTypeMatches(rtc.ResourceTypes, resourceType)
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// Any ResourceTypeTags are applied by first calling ForResourceType.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
@@ -88,6 +146,87 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// AppliesTo returns whether the required tag applies to the given resource type.
+// When the resource type is unknown only tags without resource type patterns apply.
+func (rt *RequiredTag) AppliesTo(resourceType string) bool {
+	if resourceTypeMatches(rt.ExcludeResourceTypes, resourceType) {
+		return false
+	}
+
+	if len(rt.ResourceTypes) == 0 {
+		return true
+	}
+
+	return resourceTypeMatches(rt.ResourceTypes, resourceType)
+}
+
+// resourceTypeMatches returns whether the resource type matches any of the path.Match patterns.
+func resourceTypeMatches(patterns []string, resourceType string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, resourceType); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns an error describing every required tag missing from, or with a
+// disallowed value in, the given tags of a resource of the given type.
+// Required tags removed by the given ignore configuration are managed outside of
+// Terraform and are not validated. Tags with unknown (nil) values are not validated
+// against the allowed values regular expression.
+func (rc *RequiredConfig) Validate(resourceType string, tags KeyValueTags, ignoreConfig *IgnoreConfig) error {
+	if rc == nil {
+		return nil
+	}
+
+	var missing []string
+	var disallowed []string
+
+	for _, rt := range rc.Tags {
+		if rt == nil || !rt.AppliesTo(resourceType) {
+			continue
+		}
+
+		if len(New([]string{rt.Key}).IgnoreConfig(ignoreConfig)) == 0 {
+			continue
+		}
+
+		if !tags.KeyExists(rt.Key) {
+			missing = append(missing, fmt.Sprintf("%q", rt.Key))
+			continue
+		}
+
+		if rt.AllowedValuesRegex == nil {
+			continue
+		}
+
+		if v := tags.KeyValue(rt.Key); v != nil && !rt.AllowedValuesRegex.MatchString(*v) {
+			disallowed = append(disallowed, fmt.Sprintf("%q (value %q does not match %q)", rt.Key, *v, rt.AllowedValuesRegex.String()))
+		}
+	}
+
+	if len(missing) == 0 && len(disallowed) == 0 {
+		return nil
+	}
+
+	resource := "resource"
+	if resourceType != "" {
+		resource = resourceType
+	}
+
+	var msgs []string
+	if len(missing) > 0 {
+		msgs = append(msgs, fmt.Sprintf("missing required tags: %s", strings.Join(missing, ", ")))
+	}
+	if len(disallowed) > 0 {
+		msgs = append(msgs, fmt.Sprintf("tags with disallowed values: %s", strings.Join(disallowed, ", ")))
+	}
+
+	return fmt.Errorf(`%s does not satisfy the "required_tags" configuration of the provider: %s`, resource, strings.Join(msgs, "; "))
+}
+
 // IgnoreConfig returns any tags not removed by a given configuration.
 func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 	if config == nil {
@@ -96,6 +235,7 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreRegexes(config.KeyRegexes)
 
 	return result
 }
@@ -147,6 +287,30 @@ func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValue
 	return result
 }
 
+// IgnoreRegexes returns tag keys not matching any of the regular expressions.
+func (tags KeyValueTags) IgnoreRegexes(ignoreTagRegexes []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		var ignore bool
+
+		for _, ignoreTagRegex := range ignoreTagRegexes {
+			if ignoreTagRegex.MatchString(k) {
+				ignore = true
+				break
+			}
+		}
+
+		if ignore {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnoreRDS returns non-AWS and non-RDS tag keys.
 func (tags KeyValueTags) IgnoreRDS() KeyValueTags {
 	result := make(KeyValueTags)
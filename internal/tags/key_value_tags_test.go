@@ -1,7 +1,10 @@
 package tags
 
 import (
+	"regexp"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
 )
 
 func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
@@ -183,6 +186,145 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResourceType(t *testing.T) {
+	defaultConfig := &DefaultConfig{
+		Tags: New(map[string]string{
+			"key1": "value1",
+			"key2": "value2",
+		}),
+		ResourceTypeTags: []*ResourceTypeDefaultConfig{
+			{
+				ResourceTypes: []string{"aws_ebs_*", "aws_db_instance"},
+				Tags: New(map[string]string{
+					"backup": "daily",
+				}),
+			},
+			{
+				ResourceTypes: []string{"aws_ebs_snapshot"},
+				Tags: New(map[string]string{
+					"backup": "none",
+					"key2":   "snapshot",
+				}),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		resourceType  string
+		tags          KeyValueTags
+		want          map[string]string
+	}{
+		{
+			name:          "no config",
+			defaultConfig: nil,
+			resourceType:  "aws_instance",
+			tags: New(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "no resource type tags",
+			defaultConfig: &DefaultConfig{
+				Tags: New(map[string]string{
+					"key1": "value1",
+				}),
+			},
+			resourceType: "aws_instance",
+			tags: New(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key3": "value3",
+			},
+		},
+		{
+			name:          "no matching resource types",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_instance",
+			tags: New(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name:          "matching resource type pattern",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_ebs_volume",
+			tags: New(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"backup": "daily",
+				"key1":   "value1",
+				"key2":   "value2",
+				"key3":   "value3",
+			},
+		},
+		{
+			name:          "multiple matching resource types",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_ebs_snapshot",
+			tags: New(map[string]string{
+				"key3": "value3",
+			}),
+			want: map[string]string{
+				"backup": "none",
+				"key1":   "value1",
+				"key2":   "snapshot",
+				"key3":   "value3",
+			},
+		},
+		{
+			name:          "resource tags override",
+			defaultConfig: defaultConfig,
+			resourceType:  "aws_db_instance",
+			tags: New(map[string]string{
+				"backup": "weekly",
+			}),
+			want: map[string]string{
+				"backup": "weekly",
+				"key1":   "value1",
+				"key2":   "value2",
+			},
+		},
+		{
+			name: "matching resource type without default tags",
+			defaultConfig: &DefaultConfig{
+				ResourceTypeTags: []*ResourceTypeDefaultConfig{
+					{
+						ResourceTypes: []string{"aws_instance"},
+						Tags: New(map[string]string{
+							"backup": "daily",
+						}),
+					},
+				},
+			},
+			resourceType: "aws_instance",
+			tags:         New(map[string]string{}),
+			want: map[string]string{
+				"backup": "daily",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.defaultConfig.ForResourceType(testCase.resourceType).MergeTags(testCase.tags)
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -533,6 +675,46 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "key regexes some matching",
+			tags: New(map[string]string{
+				"kubernetes.io/cluster/example": "owned",
+				"scanner-20220101":              "clean",
+				"key3":                          "value3",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`^kubernetes\.io/cluster/`),
+					regexp.MustCompile(`^scanner-[0-9]{8}$`),
+				},
+			},
+			want: map[string]string{
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys key prefixes and key regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+				"key4": "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{
+					"key1",
+				}),
+				KeyPrefixes: New([]string{
+					"key2",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexp.MustCompile(`3$`),
+				},
+			},
+			want: map[string]string{
+				"key4": "value4",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -544,6 +726,165 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestRequiredTagAppliesTo(t *testing.T) {
+	testCases := []struct {
+		name         string
+		requiredTag  *RequiredTag
+		resourceType string
+		want         bool
+	}{
+		{
+			name:         "no patterns",
+			requiredTag:  &RequiredTag{Key: "owner"},
+			resourceType: "aws_instance",
+			want:         true,
+		},
+		{
+			name:         "no patterns unknown resource type",
+			requiredTag:  &RequiredTag{Key: "owner"},
+			resourceType: "",
+			want:         true,
+		},
+		{
+			name:         "resource types matching",
+			requiredTag:  &RequiredTag{Key: "owner", ResourceTypes: []string{"aws_instance", "aws_ebs_*"}},
+			resourceType: "aws_ebs_volume",
+			want:         true,
+		},
+		{
+			name:         "resource types not matching",
+			requiredTag:  &RequiredTag{Key: "owner", ResourceTypes: []string{"aws_instance", "aws_ebs_*"}},
+			resourceType: "aws_vpc",
+			want:         false,
+		},
+		{
+			name:         "resource types unknown resource type",
+			requiredTag:  &RequiredTag{Key: "owner", ResourceTypes: []string{"aws_instance"}},
+			resourceType: "",
+			want:         false,
+		},
+		{
+			name:         "exclude resource types matching",
+			requiredTag:  &RequiredTag{Key: "owner", ResourceTypes: []string{"aws_ebs_*"}, ExcludeResourceTypes: []string{"aws_ebs_snapshot*"}},
+			resourceType: "aws_ebs_snapshot_copy",
+			want:         false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.requiredTag.AppliesTo(testCase.resourceType)
+
+			if got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRequiredConfigValidate(t *testing.T) {
+	requiredConfig := &RequiredConfig{
+		Tags: []*RequiredTag{
+			{
+				Key: "owner",
+			},
+			{
+				Key:                "cost-center",
+				AllowedValuesRegex: regexp.MustCompile(`^cc-[0-9]+$`),
+			},
+			{
+				Key:           "backup",
+				ResourceTypes: []string{"aws_ebs_volume"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		requiredConfig *RequiredConfig
+		resourceType   string
+		tags           KeyValueTags
+		ignoreConfig   *IgnoreConfig
+		wantErr        *regexp.Regexp
+	}{
+		{
+			name:           "no config",
+			requiredConfig: nil,
+			resourceType:   "aws_instance",
+			tags:           New(map[string]string{}),
+		},
+		{
+			name:           "all present",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: New(map[string]string{
+				"owner":       "team",
+				"cost-center": "cc-123",
+			}),
+		},
+		{
+			name:           "missing keys",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_ebs_volume",
+			tags: New(map[string]string{
+				"cost-center": "cc-123",
+			}),
+			wantErr: regexp.MustCompile(`aws_ebs_volume .* missing required tags: "owner", "backup"$`),
+		},
+		{
+			name:           "disallowed value",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: New(map[string]string{
+				"owner":       "team",
+				"cost-center": "finance",
+			}),
+			wantErr: regexp.MustCompile(`tags with disallowed values: "cost-center" \(value "finance" does not match`),
+		},
+		{
+			name:           "unknown value",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: New(map[string]*string{
+				"owner": aws.String("team"),
+			}).Merge(KeyValueTags{"cost-center": &TagData{}}),
+		},
+		{
+			name:           "ignored key",
+			requiredConfig: requiredConfig,
+			resourceType:   "aws_instance",
+			tags: New(map[string]string{
+				"cost-center": "cc-123",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New([]string{"owner"}),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.requiredConfig.Validate(testCase.resourceType, testCase.tags, testCase.ignoreConfig)
+
+			if testCase.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q, got none", testCase.wantErr)
+			}
+
+			if !testCase.wantErr.MatchString(err.Error()) {
+				t.Errorf("expected error matching %q, got %q", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -689,6 +1030,75 @@ func TestKeyValueTagsIgnorePrefixes(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsIgnoreRegexes(t *testing.T) {
+	testCases := []struct {
+		name             string
+		tags             KeyValueTags
+		ignoreTagRegexes []*regexp.Regexp
+		want             map[string]string
+	}{
+		{
+			name: "all matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+				"key3": "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^key[0-9]$`),
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "some matching",
+			tags: New(map[string]string{
+				"key1":       "value1",
+				"other-key2": "value2",
+				"key3":       "value3",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^other-`),
+				regexp.MustCompile(`3$`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+		{
+			name: "none matching",
+			tags: New(map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			ignoreTagRegexes: []*regexp.Regexp{
+				regexp.MustCompile(`^KEY`),
+			},
+			want: map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			},
+		},
+		{
+			name: "no regexes",
+			tags: New(map[string]string{
+				"key1": "value1",
+			}),
+			ignoreTagRegexes: nil,
+			want: map[string]string{
+				"key1": "value1",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.tags.IgnoreRegexes(testCase.ignoreTagRegexes)
+
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want)
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreRDS(t *testing.T) {
 	testCases := []struct {
 		name string
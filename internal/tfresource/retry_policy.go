@@ -0,0 +1,274 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+const (
+	defaultRetryMinBackoff = 500 * time.Millisecond
+	defaultRetryMaxBackoff = 10 * time.Second
+)
+
+// RetryableErrorMessage is an AWS error code and message substring indicating a retryable error.
+// An empty Code matches the message against any error, e.g. errors that are no longer AWS errors.
+type RetryableErrorMessage struct {
+	Code    string
+	Message string
+}
+
+// RetryPolicy configures how a function is retried and which of its errors are retryable.
+// The zero value of each field other than Timeout selects the default behavior, matching the retry functions of the Terraform Plugin SDK.
+// Unlike those functions, the policy's retry functions stop retrying when their context is canceled.
+type RetryPolicy struct {
+	Timeout     time.Duration // Time after which to stop retrying. The function is called one last time at the timeout. Zero for no limit other than MaxAttempts.
+	MaxAttempts int           // Maximum number of attempts. Zero for no limit other than Timeout. The function is called once if both are zero.
+	Delay       time.Duration // Wait this time before the first attempt.
+	MinBackoff  time.Duration // Wait before the first retry, doubled for each retry. Defaults to 500ms.
+	MaxBackoff  time.Duration // Maximum wait between retries. Defaults to 10s.
+	Jitter      bool          // Randomize waits, to avoid concurrent callers retrying at the same time.
+
+	ErrorCodes      []string                // Retryable AWS error codes.
+	ErrorMessages   []RetryableErrorMessage // Retryable AWS error codes and messages.
+	HTTPStatusCodes []int                   // Retryable HTTP response status codes.
+}
+
+var (
+	// IAMPropagationRetryPolicy retries while IAM changes, such as new roles or policies, propagate to other services.
+	// It retries the errors common to several services. Services add their own with WithErrorMessage.
+	IAMPropagationRetryPolicy = RetryPolicy{
+		Timeout:    2 * time.Minute,
+		MinBackoff: 1 * time.Second,
+		Jitter:     true,
+		ErrorMessages: []RetryableErrorMessage{
+			// e.g. Lambda: The role defined for the function cannot be assumed by Lambda.
+			{Code: "InvalidParameterValueException", Message: "cannot be assumed"},
+			// e.g. Lambda: The provided execution role does not have permissions to call CreateNetworkInterface on EC2.
+			{Code: "InvalidParameterValueException", Message: "The provided execution role does not have permissions"},
+			// e.g. Firehose: Firehose is unable to assume role arn:aws:iam::123456789012:role/example. Please check the role provided.
+			{Code: "InvalidArgumentException", Message: "is unable to assume role"},
+			// e.g. EC2: Value (example) for parameter iamInstanceProfile.name is invalid. Invalid IAM Instance Profile name.
+			{Code: "InvalidParameterValue", Message: "Invalid IAM Instance Profile name"},
+			// e.g. IAM: Invalid principal in policy: "AWS":"arn:aws:iam::123456789012:role/example".
+			{Code: "MalformedPolicyDocument", Message: "Invalid principal in policy"},
+		},
+	}
+
+	// ThrottlingRetryPolicy retries requests rejected by AWS API rate limits.
+	ThrottlingRetryPolicy = RetryPolicy{
+		Timeout:    10 * time.Minute,
+		MinBackoff: 1 * time.Second,
+		MaxBackoff: 30 * time.Second,
+		Jitter:     true,
+		ErrorCodes: []string{
+			"RequestLimitExceeded",
+			"Throttling",
+			"ThrottlingException",
+			"TooManyRequestsException",
+		},
+		HTTPStatusCodes: []int{429},
+	}
+)
+
+// WithTimeout returns a copy of the policy with the specified timeout.
+func (p RetryPolicy) WithTimeout(timeout time.Duration) RetryPolicy {
+	p.Timeout = timeout
+
+	return p
+}
+
+// WithErrorCodes returns a copy of the policy which also retries the specified AWS error codes.
+func (p RetryPolicy) WithErrorCodes(codes ...string) RetryPolicy {
+	p.ErrorCodes = append(append([]string(nil), p.ErrorCodes...), codes...)
+
+	return p
+}
+
+// WithErrorMessage returns a copy of the policy which also retries AWS errors with the specified code containing the specified message.
+func (p RetryPolicy) WithErrorMessage(code, message string) RetryPolicy {
+	p.ErrorMessages = append(append([]RetryableErrorMessage(nil), p.ErrorMessages...), RetryableErrorMessage{Code: code, Message: message})
+
+	return p
+}
+
+// IsRetryable returns whether the error is retryable according to the policy.
+func (p RetryPolicy) IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if len(p.ErrorCodes) > 0 && tfawserr.ErrCodeEquals(err, p.ErrorCodes...) {
+		return true
+	}
+
+	for _, v := range p.ErrorMessages {
+		if v.Code == "" {
+			if strings.Contains(err.Error(), v.Message) {
+				return true
+			}
+
+			continue
+		}
+
+		if tfawserr.ErrMessageContains(err, v.Code, v.Message) {
+			return true
+		}
+	}
+
+	if len(p.HTTPStatusCodes) > 0 {
+		if statusCode, ok := httpStatusCode(err); ok {
+			for _, v := range p.HTTPStatusCodes {
+				if v == statusCode {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// RetryContext retries the function `f` while the error it returns is retryable according to the policy.
+func (p RetryPolicy) RetryContext(ctx context.Context, f func() (interface{}, error)) (interface{}, error) {
+	return p.RetryWhenContext(ctx, f, func(err error) (bool, error) {
+		return false, err
+	})
+}
+
+// RetryWhenContext retries the function `f` while the error it returns satisfies `retryable`
+// or is retryable according to the policy.
+func (p RetryPolicy) RetryWhenContext(ctx context.Context, f func() (interface{}, error), retryable Retryable) (interface{}, error) {
+	var deadline time.Time
+	if p.Timeout > 0 {
+		deadline = time.Now().Add(p.Timeout)
+	}
+
+	if err := sleepContext(ctx, p.jitter(p.Delay)); err != nil {
+		return nil, err
+	}
+
+	// Without a timeout or maximum number of attempts the function is not retried.
+	for attempt, lastAttempt := 1, p.Timeout <= 0 && p.MaxAttempts <= 0; ; attempt++ {
+		output, err := f()
+		retry, rerr := retryable(err)
+
+		if !retry && p.IsRetryable(err) {
+			retry, rerr = true, err
+		}
+
+		if !retry {
+			if rerr != nil {
+				return nil, rerr
+			}
+
+			return output, nil
+		}
+
+		// As with resource.Retry, the last retryable error is returned as is at the timeout or the single attempt,
+		// and the result of the call is returned if it is retryable without an error.
+		if lastAttempt {
+			if rerr != nil {
+				return nil, rerr
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			return output, nil
+		}
+
+		if p.MaxAttempts > 0 && attempt >= p.MaxAttempts {
+			return nil, rerr
+		}
+
+		wait := p.backoff(attempt)
+
+		// As with the retry functions of the Terraform Plugin SDK, the function is called one last time at the timeout.
+		if !deadline.IsZero() && time.Now().Add(wait).After(deadline) {
+			wait = time.Until(deadline)
+			lastAttempt = true
+		}
+
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// RetryWhenAWSErrCodeEqualsContext retries the specified function when it returns one of the specified AWS error codes,
+// or an error retryable according to the policy.
+func (p RetryPolicy) RetryWhenAWSErrCodeEqualsContext(ctx context.Context, f func() (interface{}, error), codes ...string) (interface{}, error) { // nosemgrep:aws-in-func-name
+	return p.WithErrorCodes(codes...).RetryContext(ctx, f)
+}
+
+// backoff returns the wait after the specified attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	minBackoff, maxBackoff := p.MinBackoff, p.MaxBackoff
+
+	if minBackoff <= 0 {
+		minBackoff = defaultRetryMinBackoff
+	}
+
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+
+	wait := minBackoff
+	for i := 1; i < attempt && wait < maxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > maxBackoff {
+		wait = maxBackoff
+	}
+
+	return p.jitter(wait)
+}
+
+// jitter returns a random duration between half the specified duration and the duration, if the policy has jitter.
+func (p RetryPolicy) jitter(d time.Duration) time.Duration {
+	if !p.Jitter || d <= 1 {
+		return d
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2))) //nolint:gosec
+}
+
+// httpStatusCode returns the HTTP response status code of an AWS SDK error, if any.
+func httpStatusCode(err error) (int, bool) {
+	var requestFailure awserr.RequestFailure
+	if errors.As(err, &requestFailure) {
+		return requestFailure.StatusCode(), true
+	}
+
+	// AWS SDK for Go v2 errors.
+	var responseError interface{ HTTPStatusCode() int }
+	if errors.As(err, &responseError) {
+		return responseError.HTTPStatusCode(), true
+	}
+
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
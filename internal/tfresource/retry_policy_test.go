@@ -0,0 +1,241 @@
+package tfresource_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	policy := tfresource.RetryPolicy{
+		ErrorCodes:      []string{"TestCode1"},
+		HTTPStatusCodes: []int{429},
+	}.WithErrorMessage("TestCode2", "propagating").WithErrorMessage("", "Throttling")
+
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil error",
+		},
+		{
+			Name:     "error code",
+			Err:      awserr.New("TestCode1", "TestMessage", nil),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped error code",
+			Err:      fmt.Errorf("error creating: %w", awserr.New("TestCode1", "TestMessage", nil)),
+			Expected: true,
+		},
+		{
+			Name:     "error code and message",
+			Err:      awserr.New("TestCode2", "role is propagating", nil),
+			Expected: true,
+		},
+		{
+			Name: "error code and other message",
+			Err:  awserr.New("TestCode2", "TestMessage", nil),
+		},
+		{
+			Name:     "message of any error",
+			Err:      errors.New("error deleting: Throttling: Rate exceeded"),
+			Expected: true,
+		},
+		{
+			Name:     "HTTP status code",
+			Err:      awserr.NewRequestFailure(awserr.New("TestCode3", "TestMessage", nil), 429, "request-id"),
+			Expected: true,
+		},
+		{
+			Name: "other HTTP status code",
+			Err:  awserr.NewRequestFailure(awserr.New("TestCode3", "TestMessage", nil), 400, "request-id"),
+		},
+		{
+			Name: "other error",
+			Err:  errors.New("TestCode"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := policy.IsRetryable(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestIAMPropagationRetryPolicyIsRetryable(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name:     "Lambda role cannot be assumed",
+			Err:      awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil),
+			Expected: true,
+		},
+		{
+			Name:     "Lambda execution role permissions",
+			Err:      awserr.New("InvalidParameterValueException", "The provided execution role does not have permissions to call CreateNetworkInterface on EC2", nil),
+			Expected: true,
+		},
+		{
+			Name:     "Firehose role cannot be assumed",
+			Err:      awserr.New("InvalidArgumentException", "Firehose is unable to assume role arn:aws:iam::123456789012:role/example. Please check the role provided.", nil),
+			Expected: true,
+		},
+		{
+			Name:     "EC2 instance profile",
+			Err:      awserr.New("InvalidParameterValue", "Value (example) for parameter iamInstanceProfile.name is invalid. Invalid IAM Instance Profile name", nil),
+			Expected: true,
+		},
+		{
+			Name:     "IAM principal",
+			Err:      awserr.New("MalformedPolicyDocument", `Invalid principal in policy: "AWS":"arn:aws:iam::123456789012:role/example"`, nil),
+			Expected: true,
+		},
+		{
+			Name: "other message",
+			Err:  awserr.New("InvalidParameterValueException", "Unzipped size must be smaller than 262144000 bytes", nil),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := tfresource.IAMPropagationRetryPolicy.IsRetryable(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyWithErrorCodes(t *testing.T) {
+	policy := tfresource.RetryPolicy{ErrorCodes: []string{"TestCode1"}}
+	extended := policy.WithErrorCodes("TestCode2")
+
+	if got, expected := len(policy.ErrorCodes), 1; got != expected {
+		t.Errorf("got %d original error codes, expected %d", got, expected)
+	}
+
+	if !extended.IsRetryable(awserr.New("TestCode2", "TestMessage", nil)) {
+		t.Error("expected added error code to be retryable")
+	}
+}
+
+func TestRetryPolicyRetryContext(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name          string
+		Policy        tfresource.RetryPolicy
+		F             func() (interface{}, error)
+		ExpectError   bool
+		ExpectedCalls int32
+	}{
+		{
+			Name:   "no error",
+			Policy: tfresource.RetryPolicy{Timeout: 5 * time.Second},
+			F: func() (interface{}, error) {
+				atomic.AddInt32(&retryCount, 1)
+				return nil, nil
+			},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:   "non-retryable error",
+			Policy: tfresource.RetryPolicy{Timeout: 5 * time.Second, ErrorCodes: []string{"TestCode1"}},
+			F: func() (interface{}, error) {
+				atomic.AddInt32(&retryCount, 1)
+				return nil, awserr.New("TestCode2", "TestMessage", nil)
+			},
+			ExpectError:   true,
+			ExpectedCalls: 1,
+		},
+		{
+			Name:   "retryable error success",
+			Policy: tfresource.RetryPolicy{Timeout: 5 * time.Second, MinBackoff: 10 * time.Millisecond, ErrorCodes: []string{"TestCode1"}},
+			F: func() (interface{}, error) {
+				if atomic.AddInt32(&retryCount, 1) < 3 {
+					return nil, awserr.New("TestCode1", "TestMessage", nil)
+				}
+
+				return nil, nil
+			},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:   "max attempts",
+			Policy: tfresource.RetryPolicy{MaxAttempts: 4, MinBackoff: 10 * time.Millisecond, Jitter: true, ErrorCodes: []string{"TestCode1"}},
+			F: func() (interface{}, error) {
+				atomic.AddInt32(&retryCount, 1)
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			ExpectError:   true,
+			ExpectedCalls: 4,
+		},
+		{
+			Name:   "no timeout or max attempts",
+			Policy: tfresource.RetryPolicy{MinBackoff: 10 * time.Millisecond, ErrorCodes: []string{"TestCode1"}},
+			F: func() (interface{}, error) {
+				atomic.AddInt32(&retryCount, 1)
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			ExpectError:   true,
+			ExpectedCalls: 1,
+		},
+		{
+			Name:   "timeout calls one last time",
+			Policy: tfresource.RetryPolicy{Timeout: 1 * time.Second, MinBackoff: 400 * time.Millisecond, MaxBackoff: 400 * time.Millisecond, ErrorCodes: []string{"TestCode1"}},
+			F: func() (interface{}, error) {
+				atomic.AddInt32(&retryCount, 1)
+				return nil, awserr.New("TestCode1", "TestMessage", nil)
+			},
+			ExpectError:   true,
+			ExpectedCalls: 4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			_, err := testCase.Policy.RetryContext(context.Background(), testCase.F)
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := atomic.LoadInt32(&retryCount); got != testCase.ExpectedCalls {
+				t.Errorf("got %d calls, expected %d", got, testCase.ExpectedCalls)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyRetryContext_canceled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	policy := tfresource.RetryPolicy{Timeout: 1 * time.Minute, MinBackoff: 1 * time.Second, ErrorCodes: []string{"TestCode1"}}
+
+	_, err := policy.RetryContext(ctx, func() (interface{}, error) {
+		return nil, awserr.New("TestCode1", "TestMessage", nil)
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got error %v, expected %v", err, context.DeadlineExceeded)
+	}
+}
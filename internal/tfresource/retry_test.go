@@ -75,6 +75,70 @@ func TestRetryWhenAWSErrCodeEquals(t *testing.T) { // nosemgrep:aws-in-func-name
 	}
 }
 
+func TestRetryWhenAWSErrCodeEqualsContext_timeout(t *testing.T) { // nosemgrep:aws-in-func-name
+	lastErr := awserr.New("TestCode1", "TestMessage", nil)
+
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(context.Background(), 1*time.Second, func() (interface{}, error) {
+		return nil, lastErr
+	}, "TestCode1")
+
+	// The error of the call at the timeout is returned as is, not wrapped in a timeout error.
+	if err != lastErr {
+		t.Fatalf("got error %v, expected %v", err, lastErr)
+	}
+
+	if tfresource.TimedOut(err) {
+		t.Error("expected error not to be a timeout error")
+	}
+}
+
+func TestRetryWhenAWSErrCodeEqualsContext_zeroTimeout(t *testing.T) { // nosemgrep:aws-in-func-name
+	var retryCount int32
+	lastErr := awserr.New("TestCode1", "TestMessage", nil)
+
+	// As with resource.Retry, a zero timeout does not retry and returns the retryable error as is.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(context.Background(), 0, func() (interface{}, error) {
+		atomic.AddInt32(&retryCount, 1)
+
+		return nil, lastErr
+	}, "TestCode1")
+
+	if err != lastErr {
+		t.Fatalf("got error %v, expected %v", err, lastErr)
+	}
+
+	// The function is called one last time if the timeout expires before the first call completes.
+	if got := atomic.LoadInt32(&retryCount); got > 2 {
+		t.Errorf("got %d calls, expected at most 2", got)
+	}
+}
+
+func TestRetryWhenContext_canceled(t *testing.T) {
+	var retryCount int32
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// As with resource.Retry, the context does not stop retrying.
+	_, err := tfresource.RetryWhenContext(ctx, 5*time.Second, func() (interface{}, error) {
+		if atomic.AddInt32(&retryCount, 1) < 3 {
+			return nil, errors.New("retryable")
+		}
+
+		return nil, nil
+	}, func(err error) (bool, error) {
+		return err != nil, err
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := atomic.LoadInt32(&retryCount), int32(3); got != expected {
+		t.Errorf("got %d calls, expected %d", got, expected)
+	}
+}
+
 func TestRetryWhenAWSErrMessageContains(t *testing.T) { // nosemgrep:aws-in-func-name
 	var retryCount int32
 
@@ -0,0 +1,136 @@
+package tfresource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// StateMachine describes how to find a resource and interpret its lifecycle states.
+// It replaces the hand-written finder based status and waiter functions of service packages:
+//
+//	sm := &tfresource.StateMachine{
+//		Find: func() (interface{}, error) {
+//			return FindCapacityReservationByID(conn, id)
+//		},
+//		Status: func(output interface{}) string {
+//			return aws.StringValue(output.(*ec2.CapacityReservation).State)
+//		},
+//		Pending: []string{ec2.CapacityReservationStatePending},
+//		Target:  []string{ec2.CapacityReservationStateActive},
+//		Failure: []string{ec2.CapacityReservationStateFailed},
+//	}
+//
+//	outputRaw, err := sm.WaitForStateContext(ctx, timeout, tfresource.WaitOpts{})
+type StateMachine struct {
+	// Find returns the resource, or an error for which NotFound returns true if the resource does not exist.
+	Find func() (interface{}, error)
+
+	// Status returns the lifecycle state of the resource.
+	Status func(interface{}) string
+
+	// FailureReason returns the reason, if any, reported by AWS for the resource's state. Optional.
+	FailureReason func(interface{}) string
+
+	Pending []string // States in which to keep waiting.
+	Target  []string // States in which waiting succeeds. Empty to wait for the resource to be deleted.
+	Failure []string // States in which waiting fails immediately. Other unexpected states also fail.
+}
+
+// StatusFunc returns a resource.StateRefreshFunc which finds the resource and returns its state.
+// If the resource is not found, a nil result is returned.
+func (sm *StateMachine) StatusFunc() resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := sm.Find()
+
+		if NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, sm.Status(output), nil
+	}
+}
+
+// WaitForStateContext waits for the resource to reach one of the target states.
+// The last resource found is returned with any error. If waiting fails or times out,
+// the failure reason reported for the last resource found is set as the error's LastError.
+func (sm *StateMachine) WaitForStateContext(ctx context.Context, timeout time.Duration, opts WaitOpts) (interface{}, error) {
+	var mu sync.Mutex
+	var last interface{}
+
+	refresh := sm.StatusFunc()
+
+	stateConf := &resource.StateChangeConf{
+		Pending: sm.Pending,
+		Target:  sm.Target,
+		Refresh: func() (interface{}, string, error) {
+			output, status, err := refresh()
+
+			if err != nil {
+				return nil, "", err
+			}
+
+			mu.Lock()
+			last = output
+			mu.Unlock()
+
+			if output != nil && sm.isFailure(status) {
+				return output, status, &resource.UnexpectedStateError{
+					State:         status,
+					ExpectedState: sm.Target,
+				}
+			}
+
+			return output, status, nil
+		},
+		Timeout:                   timeout,
+		ContinuousTargetOccurence: opts.ContinuousTargetOccurence,
+		Delay:                     opts.Delay,
+		MinTimeout:                opts.MinTimeout,
+		PollInterval:              opts.PollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if err == nil {
+		return outputRaw, nil
+	}
+
+	// On timeout no result is returned, so use the last resource found.
+	if outputRaw == nil {
+		mu.Lock()
+		outputRaw = last
+		mu.Unlock()
+	}
+
+	if outputRaw != nil && sm.FailureReason != nil {
+		if reason := sm.FailureReason(outputRaw); reason != "" {
+			SetLastError(err, errors.New(reason))
+		}
+	}
+
+	return outputRaw, err
+}
+
+// WaitForState waits for the resource to reach one of the target states.
+// See WaitForStateContext.
+func (sm *StateMachine) WaitForState(timeout time.Duration, opts WaitOpts) (interface{}, error) {
+	return sm.WaitForStateContext(context.Background(), timeout, opts)
+}
+
+func (sm *StateMachine) isFailure(status string) bool {
+	for _, v := range sm.Failure {
+		if v == status {
+			return true
+		}
+	}
+
+	return false
+}
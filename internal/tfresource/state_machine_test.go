@@ -0,0 +1,189 @@
+package tfresource_test
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type testResource struct {
+	State  string
+	Reason string
+}
+
+func TestStateMachineStatusFunc(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Find          func() (interface{}, error)
+		ExpectedState string
+		ExpectNil     bool
+		ExpectError   bool
+	}{
+		{
+			Name: "found",
+			Find: func() (interface{}, error) {
+				return &testResource{State: "available"}, nil
+			},
+			ExpectedState: "available",
+		},
+		{
+			Name: "not found",
+			Find: func() (interface{}, error) {
+				return nil, &resource.NotFoundError{}
+			},
+			ExpectNil: true,
+		},
+		{
+			Name: "error",
+			Find: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			ExpectNil:   true,
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sm := &tfresource.StateMachine{
+				Find:   testCase.Find,
+				Status: func(output interface{}) string { return output.(*testResource).State },
+			}
+
+			output, state, err := sm.StatusFunc()()
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := output == nil; got != testCase.ExpectNil {
+				t.Errorf("got nil output %t, expected %t", got, testCase.ExpectNil)
+			}
+
+			if state != testCase.ExpectedState {
+				t.Errorf("got state %q, expected %q", state, testCase.ExpectedState)
+			}
+		})
+	}
+}
+
+func TestStateMachineWaitForState(t *testing.T) {
+	var retryCount int32
+
+	testCases := []struct {
+		Name          string
+		Find          func() (interface{}, error)
+		Target        []string
+		Timeout       time.Duration
+		ExpectError   bool
+		ExpectTimeout bool
+		ExpectReason  string
+	}{
+		{
+			Name: "pending then target",
+			Find: func() (interface{}, error) {
+				if atomic.AddInt32(&retryCount, 1) < 2 {
+					return &testResource{State: "creating"}, nil
+				}
+
+				return &testResource{State: "available"}, nil
+			},
+			Target: []string{"available"},
+		},
+		{
+			Name: "failure state",
+			Find: func() (interface{}, error) {
+				return &testResource{State: "failed", Reason: "insufficient capacity"}, nil
+			},
+			Target:       []string{"available"},
+			ExpectError:  true,
+			ExpectReason: "insufficient capacity",
+		},
+		{
+			Name: "unexpected state",
+			Find: func() (interface{}, error) {
+				return &testResource{State: "unknown"}, nil
+			},
+			Target:      []string{"available"},
+			ExpectError: true,
+		},
+		{
+			Name: "timeout",
+			Find: func() (interface{}, error) {
+				return &testResource{State: "creating", Reason: "waiting for capacity"}, nil
+			},
+			Target:        []string{"available"},
+			Timeout:       1 * time.Second,
+			ExpectError:   true,
+			ExpectTimeout: true,
+			ExpectReason:  "waiting for capacity",
+		},
+		{
+			Name: "deleted",
+			Find: func() (interface{}, error) {
+				if atomic.AddInt32(&retryCount, 1) < 2 {
+					return &testResource{State: "deleting"}, nil
+				}
+
+				return nil, &resource.NotFoundError{}
+			},
+			Target: []string{},
+		},
+		{
+			Name: "find error",
+			Find: func() (interface{}, error) {
+				return nil, errors.New("TestCode")
+			},
+			Target:      []string{"available"},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			retryCount = 0
+
+			timeout := testCase.Timeout
+			if timeout == 0 {
+				timeout = 5 * time.Second
+			}
+
+			sm := &tfresource.StateMachine{
+				Find:          testCase.Find,
+				Status:        func(output interface{}) string { return output.(*testResource).State },
+				FailureReason: func(output interface{}) string { return output.(*testResource).Reason },
+				Pending:       []string{"creating", "deleting"},
+				Target:        testCase.Target,
+				Failure:       []string{"failed"},
+			}
+
+			_, err := sm.WaitForState(timeout, tfresource.WaitOpts{PollInterval: 100 * time.Millisecond})
+
+			if testCase.ExpectError && err == nil {
+				t.Fatal("expected error")
+			} else if !testCase.ExpectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil {
+				return
+			}
+
+			var timeoutErr *resource.TimeoutError
+			if got := errors.As(err, &timeoutErr); got != testCase.ExpectTimeout {
+				t.Errorf("got timeout error %t, expected %t: %s", got, testCase.ExpectTimeout, err)
+			}
+
+			if testCase.ExpectReason != "" && !strings.Contains(err.Error(), testCase.ExpectReason) {
+				t.Errorf("expected error (%s) to contain reason %q", err, testCase.ExpectReason)
+			}
+		})
+	}
+}
@@ -20,9 +20,13 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
-func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+//
+// Any tags required by the provider-level configuration are validated
+// against the merged tags.
+func SetTagsDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	requiredTagsConfig := meta.(*conns.AWSClient).RequiredTagsConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -32,6 +36,14 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
+	if requiredTagsConfig != nil && diff.NewValueKnown("tags") {
+		typeName, _ := conns.ResourceTypeNameFromContext(ctx)
+
+		if err := requiredTagsConfig.Validate(typeName, unknownTagValuesRemoved(diff, allTags), ignoreTagsConfig); err != nil {
+			return err
+		}
+	}
+
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
 	// when the merger of resource-level tags onto provider-level tags results in n > 0 tags,
 	// otherwise we mark the attribute as "Computed" only when their is a known diff (excluding an empty map)
@@ -55,6 +67,24 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 	return nil
 }
 
+// unknownTagValuesRemoved returns a copy of the tags with the value of any
+// resource tag that is not yet known (e.g. interpolated from another resource) removed.
+func unknownTagValuesRemoved(diff *schema.ResourceDiff, tags tftags.KeyValueTags) tftags.KeyValueTags {
+	result := make(tftags.KeyValueTags, len(tags))
+
+	for k, v := range tags {
+		// Map keys containing "." cannot be addressed individually.
+		if !strings.Contains(k, ".") && !diff.NewValueKnown(fmt.Sprintf("tags.%s", k)) {
+			result[k] = &tftags.TagData{}
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // SuppressEquivalentStringCaseInsensitive provides custom difference suppression
 // for strings that are equal under case-insensitivity.
 func SuppressEquivalentStringCaseInsensitive(k, old, new string, d *schema.ResourceData) bool {
@@ -9,7 +9,6 @@ import (
 	"regexp"
 	"strings"
 
-	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
@@ -31,7 +30,7 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 		return true
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 	if err != nil {
 		return false
 	}
@@ -112,7 +111,7 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 		return new, nil
 	}
 
-	equivalent, err := awspolicy.PoliciesAreEquivalent(old, new)
+	equivalent, err := PoliciesAreEquivalent(old, new)
 
 	if err != nil {
 		return "", err
@@ -126,7 +125,8 @@ func SecondJSONUnlessEquivalent(old, new string) (string, error) {
 }
 
 // PolicyToSet returns the existing policy if the new policy is equivalent.
-// Otherwise, it returns the new policy. Either policy is normalized.
+// Otherwise, it returns the new policy in canonical form, see CanonicalPolicyJSON.
+// Either policy is normalized.
 func PolicyToSet(exist, new string) (string, error) {
 	policyToSet, err := SecondJSONUnlessEquivalent(exist, new)
 
@@ -134,6 +134,12 @@ func PolicyToSet(exist, new string) (string, error) {
 		return "", fmt.Errorf("while checking equivalency of existing policy (%s) and new policy (%s), encountered: %w", exist, new, err)
 	}
 
+	if policyToSet == new && policyToSet != exist {
+		if policy, err := CanonicalPolicyJSON(policyToSet); err == nil {
+			return policy, nil
+		}
+	}
+
 	policyToSet, err = structure.NormalizeJsonString(policyToSet)
 
 	if err != nil {
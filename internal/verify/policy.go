@@ -0,0 +1,299 @@
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// IAM policy statement elements whose values are sets of strings.
+var policyStatementSetElements = []string{"Action", "NotAction", "NotResource", "Resource"}
+
+// CanonicalPolicyJSON returns the canonical form of an IAM policy document, so that equivalent
+// policies are stored identically and changed policies show only their differences:
+//   - Statements are always a list, sorted by Sid and then content
+//   - Empty Sids are removed
+//   - Action, Resource and principal lists are sorted and de-duplicated, and single-element lists collapsed
+//   - A principal of {"AWS": "*"} is the equivalent "*"
+//   - Condition keys, which AWS treats case-insensitively, are lower case unless keys of the same operator
+//     differ only in case, and condition values are sorted
+//   - JSON object keys are sorted and whitespace removed
+func CanonicalPolicyJSON(policy string) (string, error) {
+	var document map[string]interface{}
+
+	decoder := json.NewDecoder(strings.NewReader(policy))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&document); err != nil {
+		return "", fmt.Errorf("policy (%s) is invalid JSON: %w", policy, err)
+	}
+
+	if document == nil {
+		return "", fmt.Errorf("policy (%s) is not a JSON object", policy)
+	}
+
+	if v, ok := document["Statement"]; ok {
+		var statements []interface{}
+
+		switch v := v.(type) {
+		case []interface{}:
+			statements = v
+		case map[string]interface{}:
+			statements = []interface{}{v}
+		default:
+			return "", fmt.Errorf("policy (%s) has invalid Statement", policy)
+		}
+
+		type sortableStatement struct {
+			sid   string
+			key   string
+			value interface{}
+		}
+
+		sortable := make([]sortableStatement, 0, len(statements))
+
+		for _, statement := range statements {
+			s := sortableStatement{value: statement}
+
+			if m, ok := statement.(map[string]interface{}); ok {
+				canonicalPolicyStatement(m)
+
+				s.sid, _ = m["Sid"].(string)
+			}
+
+			key, err := marshalPolicyJSON(s.value)
+
+			if err != nil {
+				return "", err
+			}
+
+			s.key = key
+			sortable = append(sortable, s)
+		}
+
+		sort.SliceStable(sortable, func(i, j int) bool {
+			if sortable[i].sid != sortable[j].sid {
+				return sortable[i].sid < sortable[j].sid
+			}
+
+			return sortable[i].key < sortable[j].key
+		})
+
+		statements = make([]interface{}, 0, len(sortable))
+
+		for _, s := range sortable {
+			statements = append(statements, s.value)
+		}
+
+		document["Statement"] = statements
+	}
+
+	return marshalPolicyJSON(document)
+}
+
+// PolicyStateFunc is a schema.StateFunc storing IAM policy documents in canonical form.
+// Values which are not valid policy documents are stored normalized, or unchanged if not valid JSON.
+func PolicyStateFunc(v interface{}) string {
+	s, _ := v.(string)
+
+	if policy, err := CanonicalPolicyJSON(s); err == nil {
+		return policy
+	}
+
+	if json, err := structure.NormalizeJsonString(s); err == nil {
+		return json
+	}
+
+	return s
+}
+
+// PoliciesAreEquivalent returns whether the IAM policy documents are equivalent,
+// including policies differing only in the normalizations of CanonicalPolicyJSON.
+func PoliciesAreEquivalent(policy1, policy2 string) (bool, error) {
+	equivalent, err := awspolicy.PoliciesAreEquivalent(policy1, policy2)
+
+	if err != nil || equivalent {
+		return equivalent, err
+	}
+
+	canonical1, err := CanonicalPolicyJSON(policy1)
+
+	if err != nil {
+		return false, nil
+	}
+
+	canonical2, err := CanonicalPolicyJSON(policy2)
+
+	if err != nil {
+		return false, nil
+	}
+
+	if canonical1 == canonical2 {
+		return true, nil
+	}
+
+	return awspolicy.PoliciesAreEquivalent(canonical1, canonical2)
+}
+
+func canonicalPolicyStatement(statement map[string]interface{}) {
+	if sid, ok := statement["Sid"].(string); ok && sid == "" {
+		delete(statement, "Sid")
+	}
+
+	for _, k := range policyStatementSetElements {
+		if v, ok := statement[k]; ok {
+			statement[k] = canonicalPolicyStringSet(v)
+		}
+	}
+
+	for _, k := range []string{"Principal", "NotPrincipal"} {
+		if v, ok := statement[k]; ok {
+			statement[k] = canonicalPolicyPrincipal(v)
+		}
+	}
+
+	if v, ok := statement["Condition"].(map[string]interface{}); ok {
+		for operator, conditions := range v {
+			conditions, ok := conditions.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			// Keys under an operator are ANDed, so keys differing only in case are kept as is, not merged.
+			lowerCase := true
+			lowerCaseKeys := make(map[string]bool, len(conditions))
+
+			for key := range conditions {
+				if lowerCaseKeys[strings.ToLower(key)] {
+					lowerCase = false
+				}
+
+				lowerCaseKeys[strings.ToLower(key)] = true
+			}
+
+			canonical := make(map[string]interface{}, len(conditions))
+
+			for key, values := range conditions {
+				if lowerCase {
+					key = strings.ToLower(key)
+				}
+
+				canonical[key] = canonicalPolicyStringSet(values)
+			}
+
+			v[operator] = canonical
+		}
+	}
+}
+
+func canonicalPolicyPrincipal(v interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+
+	if !ok {
+		return v
+	}
+
+	for k, principals := range m {
+		m[k] = canonicalPolicyStringSet(principals)
+	}
+
+	if len(m) == 1 && m["AWS"] == "*" {
+		return "*"
+	}
+
+	return m
+}
+
+// canonicalPolicyStringSet returns the sorted, de-duplicated list of the string or list of strings,
+// or the string for a single-element list. Numbers and booleans are kept as such.
+// Other values are returned unchanged.
+func canonicalPolicyStringSet(v interface{}) interface{} {
+	values := policyValueList(v)
+
+	if values == nil {
+		return v
+	}
+
+	type sortableValue struct {
+		key   string
+		typ   string
+		value interface{}
+	}
+
+	set := make(map[string]bool, len(values))
+	sortable := make([]sortableValue, 0, len(values))
+
+	for _, value := range values {
+		var s string
+
+		switch value := value.(type) {
+		case string:
+			s = value
+		case json.Number:
+			s = value.String()
+		case bool:
+			s = strconv.FormatBool(value)
+		default:
+			return v
+		}
+
+		// Values of different types with the same string form, e.g. "1" and 1, are kept.
+		typ := fmt.Sprintf("%T", value)
+
+		if k := typ + ":" + s; !set[k] {
+			set[k] = true
+			sortable = append(sortable, sortableValue{key: s, typ: typ, value: value})
+		}
+	}
+
+	sort.Slice(sortable, func(i, j int) bool {
+		if sortable[i].key != sortable[j].key {
+			return sortable[i].key < sortable[j].key
+		}
+
+		return sortable[i].typ < sortable[j].typ
+	})
+
+	if len(sortable) == 1 {
+		return sortable[0].value
+	}
+
+	list := make([]interface{}, 0, len(sortable))
+
+	for _, s := range sortable {
+		list = append(list, s.value)
+	}
+
+	return list
+}
+
+func policyValueList(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case string, json.Number, bool:
+		return []interface{}{v}
+	}
+
+	return nil
+}
+
+func marshalPolicyJSON(v interface{}) (string, error) {
+	var b bytes.Buffer
+
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
@@ -0,0 +1,260 @@
+package verify
+
+import (
+	"testing"
+)
+
+func TestCanonicalPolicyJSON(t *testing.T) {
+	testCases := []struct {
+		name      string
+		policy    string
+		want      string
+		wantError bool
+	}{
+		{
+			name:   "empty object",
+			policy: `{}`,
+			want:   `{}`,
+		},
+		{
+			name: "statement object",
+			policy: `{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Effect": "Allow",
+    "Action": ["s3:PutObject", "s3:GetObject", "s3:GetObject"],
+    "Resource": ["arn:aws:s3:::bucket/*"]
+  }
+}`,
+			want: `{"Statement":[{"Action":["s3:GetObject","s3:PutObject"],"Effect":"Allow","Resource":"arn:aws:s3:::bucket/*"}],"Version":"2012-10-17"}`, //lintignore:AWSAT005
+		},
+		{
+			name: "statements sorted by sid and content",
+			policy: `{
+  "Statement": [
+    {"Sid": "", "Effect": "Deny", "Action": "s3:*", "Resource": "*"},
+    {"Sid": "B", "Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"},
+    {"Sid": "A", "Effect": "Allow", "Action": "s3:PutObject", "Resource": "*"},
+    {"Effect": "Allow", "Action": "s3:*", "Resource": "*"}
+  ]
+}`,
+			want: `{"Statement":[{"Action":"s3:*","Effect":"Allow","Resource":"*"},{"Action":"s3:*","Effect":"Deny","Resource":"*"},{"Action":"s3:PutObject","Effect":"Allow","Resource":"*","Sid":"A"},{"Action":"s3:GetObject","Effect":"Allow","Resource":"*","Sid":"B"}]}`,
+		},
+		{
+			name: "principals",
+			policy: `{
+  "Statement": [
+    {"Sid": "A", "Effect": "Allow", "Action": "sqs:*", "Principal": {"AWS": ["*"]}},
+    {"Sid": "B", "Effect": "Allow", "Action": "sqs:*", "Principal": {"Service": ["sns.amazonaws.com", "events.amazonaws.com"], "AWS": ["arn:aws:iam::123456789012:root"]}}
+  ]
+}`,
+			want: `{"Statement":[{"Action":"sqs:*","Effect":"Allow","Principal":"*","Sid":"A"},{"Action":"sqs:*","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root","Service":["events.amazonaws.com","sns.amazonaws.com"]},"Sid":"B"}]}`, //lintignore:AWSAT005
+		},
+		{
+			name: "conditions",
+			policy: `{
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "sqs:SendMessage",
+    "Resource": "*",
+    "Condition": {
+      "ArnEquals": {"aws:SourceArn": ["b", "a"]},
+      "StringEquals": {"aws:PrincipalTag/Team": "a", "aws:principaltag/team": "b"},
+      "NumericLessThan": {"s3:max-keys": [10]},
+      "Bool": {"aws:SecureTransport": false}
+    }
+  }]
+}`,
+			want: `{"Statement":[{"Action":"sqs:SendMessage","Condition":{"ArnEquals":{"aws:sourcearn":["a","b"]},"Bool":{"aws:securetransport":false},"NumericLessThan":{"s3:max-keys":10},"StringEquals":{"aws:PrincipalTag/Team":"a","aws:principaltag/team":"b"}},"Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			name: "condition value types",
+			policy: `{
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "s3:GetObject",
+    "Resource": "*",
+    "Condition": {
+      "NumericLessThan": {"aws:MultiFactorAuthAge": [60, "3600", 3600, 60]},
+      "Bool": {"aws:SecureTransport": [true, false, "true"]}
+    }
+  }]
+}`,
+			want: `{"Statement":[{"Action":"s3:GetObject","Condition":{"Bool":{"aws:securetransport":[false,true,"true"]},"NumericLessThan":{"aws:multifactorauthage":[3600,"3600",60]}},"Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			name:   "no HTML escaping",
+			policy: `{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/<key>&"}]}`, //lintignore:AWSAT005
+			want:   `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"arn:aws:s3:::bucket/<key>&"}]}`, //lintignore:AWSAT005
+		},
+		{
+			name:      "invalid JSON",
+			policy:    `{"Statement":`,
+			wantError: true,
+		},
+		{
+			name:      "not an object",
+			policy:    `null`,
+			wantError: true,
+		},
+		{
+			name:      "invalid statement",
+			policy:    `{"Statement":"Allow"}`,
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := CanonicalPolicyJSON(testCase.policy)
+
+			if testCase.wantError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("got %s, wanted %s", got, testCase.want)
+			}
+
+			// Canonical form is stable.
+			if again, err := CanonicalPolicyJSON(got); err != nil || again != got {
+				t.Errorf("canonical form not stable, got %s (%v)", again, err)
+			}
+		})
+	}
+}
+
+func TestPolicyStateFunc(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{
+			name:  "policy",
+			value: `{"Statement":{"Effect":"Allow","Action":["s3:GetObject"],"Resource":"*"}}`,
+			want:  `{"Statement":[{"Action":"s3:GetObject","Effect":"Allow","Resource":"*"}]}`,
+		},
+		{
+			name:  "JSON array",
+			value: `[ 1, 2 ]`,
+			want:  `[1,2]`,
+		},
+		{
+			name:  "invalid JSON",
+			value: `{"Statement":`,
+			want:  `{"Statement":`,
+		},
+		{
+			name:  "empty",
+			value: "",
+			want:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := PolicyStateFunc(testCase.value); got != testCase.want {
+				t.Errorf("got %s, wanted %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentPolicyDiffs(t *testing.T) {
+	testCases := []struct {
+		name string
+		old  string
+		new  string
+		want bool
+	}{
+		{
+			name: "empty",
+			old:  "",
+			new:  "{}",
+			want: true,
+		},
+		{
+			name: "reordered",
+			old:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			new:  `{"Statement":[{"Resource":"*","Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow"}],"Version":"2012-10-17"}`,
+			want: true,
+		},
+		{
+			name: "condition key case",
+			old:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*","Condition":{"ArnEquals":{"aws:sourcearn":"a"}}}]}`,
+			new:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*","Condition":{"ArnEquals":{"aws:SourceArn":"a"}}}]}`,
+			want: true,
+		},
+		{
+			name: "condition keys differing in case",
+			old:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalTag/Team":"a","aws:principaltag/team":"b"}}}]}`,
+			new:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*","Condition":{"StringEquals":{"aws:principaltag/team":["a","b"]}}}]}`,
+			want: false,
+		},
+		{
+			name: "wildcard principal",
+			old:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*","Principal":"*"}]}`,
+			new:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*","Principal":{"AWS":"*"}}]}`,
+			want: true,
+		},
+		{
+			name: "duplicate actions",
+			old:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`,
+			new:  `{"Statement":[{"Effect":"Allow","Action":["sqs:*","sqs:*"],"Resource":"*"}]}`,
+			want: true,
+		},
+		{
+			name: "different",
+			old:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`,
+			new:  `{"Statement":[{"Effect":"Deny","Action":"sqs:*","Resource":"*"}]}`,
+		},
+		{
+			name: "invalid",
+			old:  `{"Statement":[{"Effect":"Allow","Action":"sqs:*","Resource":"*"}]}`,
+			new:  `{"Statement":`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if got := SuppressEquivalentPolicyDiffs("policy", testCase.old, testCase.new, nil); got != testCase.want {
+				t.Errorf("got %t, wanted %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPolicyToSet(t *testing.T) {
+	exist := `{"Statement":[{"Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow","Resource":"*"}]}`
+
+	// Equivalent policy keeps the existing, normalized policy.
+	got, err := PolicyToSet(exist, `{"Statement":{"Resource":"*","Effect":"Allow","Action":["s3:PutObject","s3:GetObject"]}}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != exist {
+		t.Errorf("got %s, wanted %s", got, exist)
+	}
+
+	// Changed policy is canonical.
+	got, err = PolicyToSet(exist, `{"Statement":{"Resource":"*","Effect":"Allow","Action":["s3:PutObject"]}}`)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"Statement":[{"Action":"s3:PutObject","Effect":"Allow","Resource":"*"}]}`; got != want {
+		t.Errorf("got %s, wanted %s", got, want)
+	}
+}
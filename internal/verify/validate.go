@@ -1,12 +1,15 @@
 package verify
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -111,6 +114,60 @@ func ValidIAMPolicyJSON(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// IAM policy document size limits, in characters excluding whitespace.
+const (
+	BucketPolicyMaxSize         = 20 * 1024
+	InlineRolePoliciesMaxSize   = 10240 // Aggregate of all of a role's inline policies.
+	KeyPolicyMaxSize            = 32 * 1024
+	ManagedPolicyMaxSize        = 6144
+	ServiceControlPolicyMaxSize = 5120
+)
+
+// PolicySize returns the size of the policy document counted against AWS limits,
+// i.e. the number of characters excluding whitespace.
+func PolicySize(policy string) (int, error) {
+	b := bytes.NewBufferString("")
+
+	if err := json.Compact(b, []byte(policy)); err != nil {
+		return 0, err
+	}
+
+	return utf8.RuneCount(b.Bytes()), nil
+}
+
+// CheckPolicySize returns an error if the policy document size exceeds limit.
+// Policies which are not valid JSON are not checked.
+func CheckPolicySize(k, policy string, limit int) error {
+	size, err := PolicySize(policy)
+
+	if err != nil {
+		return nil
+	}
+
+	if size > limit {
+		return fmt.Errorf("%q policy document size (%d characters, excluding whitespace) exceeds the limit of %d characters", k, size, limit)
+	}
+
+	return nil
+}
+
+// ValidPolicySize returns a SchemaValidateFunc which tests that the policy document size does not exceed limit.
+func ValidPolicySize(limit int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if err := CheckPolicySize(k, value, limit); err != nil {
+			errors = append(errors, err)
+		}
+
+		return
+	}
+}
+
 // ValidateIPv4CIDRBlock validates that the specified CIDR block is valid:
 // - The CIDR block parses to an IP address and network
 // - The IP address is an IPv4 address
@@ -392,6 +392,56 @@ func TestValidIAMPolicyJSONString(t *testing.T) {
 	}
 }
 
+func TestValidPolicySize(t *testing.T) {
+	policy := `{
+  "Statement": [{
+    "Effect": "Allow",
+    "Action": "s3:GetObject",
+    "Resource": "*"
+  }]
+}`
+
+	size, err := PolicySize(policy)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := len(`{"Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`); size != expected {
+		t.Fatalf("got size %d, expected %d", size, expected)
+	}
+
+	validCases := []struct {
+		Value string
+		Limit int
+	}{
+		{Value: policy, Limit: size},
+		{Value: policy, Limit: ManagedPolicyMaxSize},
+		{Value: `{"Sid": "with spaces in value"}`, Limit: 30},
+		{Value: `{"xyz":[}}`, Limit: 1}, // Invalid JSON is not checked.
+	}
+
+	for _, tc := range validCases {
+		if _, errors := ValidPolicySize(tc.Limit)(tc.Value, "policy"); len(errors) != 0 {
+			t.Errorf("Expected %q not to trigger a validation error for limit %d: %v", tc.Value, tc.Limit, errors)
+		}
+	}
+
+	invalidCases := []struct {
+		Value string
+		Limit int
+	}{
+		{Value: policy, Limit: size - 1},
+		{Value: `{"Sid": "with spaces in value"}`, Limit: 29},
+	}
+
+	for _, tc := range invalidCases {
+		if _, errors := ValidPolicySize(tc.Limit)(tc.Value, "policy"); len(errors) != 1 {
+			t.Errorf("Expected %q to trigger a validation error for limit %d", tc.Value, tc.Limit)
+		}
+	}
+}
+
 func TestValidStringIsJSONOrYAML(t *testing.T) {
 	type testCases struct {
 		Value    string
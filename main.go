@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -15,7 +16,9 @@ func main() {
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := &plugin.ServeOpts{ProviderFunc: provider.Provider}
+	defer conns.CloseAPICallMetrics()
+
+	opts := &plugin.ServeOpts{GRPCProviderFunc: provider.ProtoV5ProviderServer}
 
 	if debugMode {
 		err := plugin.Debug(context.Background(), "registry.terraform.io/hashicorp/aws", opts)
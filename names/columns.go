@@ -23,5 +23,7 @@ const (
 	ColAllowedSubcategory      = 19
 	ColDeprecatedEnvVar        = 20
 	ColEnvVar                  = 21
-	ColNote                    = 22
+	ColRetryableErrors         = 22
+	ColEndpointsID             = 23
+	ColNote                    = 24
 )
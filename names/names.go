@@ -18,6 +18,7 @@ import (
 	"encoding/csv"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 )
 
@@ -33,12 +34,14 @@ type ServiceDatum struct {
 	Aliases           []string
 	Brand             string
 	DeprecatedEnvVar  string
+	EndpointsID       string
 	EnvVar            string
 	GoV1ClientName    string
 	GoV1Package       string
 	GoV2Package       string
 	HumanFriendly     string
 	ProviderNameUpper string
+	SDKVersion        string
 }
 
 // serviceData key is the AWS provider service package
@@ -89,12 +92,14 @@ func readCSVIntoServiceData() error {
 		serviceData[p] = &ServiceDatum{
 			Brand:             l[ColBrand],
 			DeprecatedEnvVar:  l[ColDeprecatedEnvVar],
+			EndpointsID:       l[ColEndpointsID],
 			EnvVar:            l[ColEnvVar],
 			GoV1ClientName:    l[ColGoV1ClientName],
 			GoV1Package:       l[ColGoV1Package],
 			GoV2Package:       l[ColGoV2Package],
 			HumanFriendly:     l[ColHumanFriendly],
 			ProviderNameUpper: l[ColProviderNameUpper],
+			SDKVersion:        l[ColSDKVersion],
 		}
 
 		a := []string{p}
@@ -109,6 +114,23 @@ func readCSVIntoServiceData() error {
 	return nil
 }
 
+// ProviderPackagesForEndpointsID returns the sorted provider package names of the services
+// with the AWS SDK for Go endpoint ID, e.g. "monitoring" for CloudWatch.
+// Some endpoint IDs are shared by multiple services, e.g. "elasticloadbalancing".
+func ProviderPackagesForEndpointsID(endpointsID string) []string {
+	var providerPackages []string
+
+	for k, v := range serviceData {
+		if endpointsID != "" && v.EndpointsID == endpointsID {
+			providerPackages = append(providerPackages, k)
+		}
+	}
+
+	sort.Strings(providerPackages)
+
+	return providerPackages
+}
+
 func ProviderPackageForAlias(serviceAlias string) (string, error) {
 	for k, v := range serviceData {
 		for _, hclKey := range v.Aliases {
@@ -167,6 +189,18 @@ func EnvVar(service string) string {
 	return ""
 }
 
+func HumanFriendly(service string) (string, error) {
+	if v, ok := serviceData[service]; ok {
+		return v.HumanFriendly, nil
+	}
+
+	if s, err := ProviderPackageForAlias(service); err == nil {
+		return HumanFriendly(s)
+	}
+
+	return "", fmt.Errorf("no service data found for %s", service)
+}
+
 func FullHumanFriendly(service string) (string, error) {
 	if v, ok := serviceData[service]; ok {
 		if v.Brand == "" {
@@ -191,6 +225,14 @@ func AWSGoV1Package(providerPackage string) (string, error) {
 	return "", fmt.Errorf("getting AWS Go SDK v1 package, %s not found", providerPackage)
 }
 
+func AWSGoV2Package(providerPackage string) (string, error) {
+	if v, ok := serviceData[providerPackage]; ok {
+		return v.GoV2Package, nil
+	}
+
+	return "", fmt.Errorf("getting AWS Go SDK v2 package, %s not found", providerPackage)
+}
+
 func AWSGoV1ClientName(providerPackage string) (string, error) {
 	if v, ok := serviceData[providerPackage]; ok {
 		return v.GoV1ClientName, nil
@@ -198,3 +240,96 @@ func AWSGoV1ClientName(providerPackage string) (string, error) {
 
 	return "", fmt.Errorf("getting AWS Go SDK v1 client name, %s not found", providerPackage)
 }
+
+// AWSGoSDKVersion returns the AWS SDK for Go major version, "1" or "2", used by the provider service package.
+func AWSGoSDKVersion(providerPackage string) (string, error) {
+	if v, ok := serviceData[providerPackage]; ok {
+		return v.SDKVersion, nil
+	}
+
+	return "", fmt.Errorf("getting AWS Go SDK version, %s not found", providerPackage)
+}
+
+// RetryableError describes an AWS API error retried by a service client,
+// declared in the RetryableErrors column of `names_data.csv`.
+type RetryableError struct {
+	// Operations retrying the error. A trailing "*" matches operation names by prefix. Empty matches all operations.
+	Operations []string
+	Code       string
+	// Message is matched as a substring of the error message. Empty matches any message.
+	Message string
+}
+
+// MatchesOperation returns whether the error is retried for the operation.
+func (e RetryableError) MatchesOperation(operation string) bool {
+	if len(e.Operations) == 0 {
+		return true
+	}
+
+	for _, v := range e.Operations {
+		if prefix := strings.TrimSuffix(v, "*"); prefix != v {
+			if strings.HasPrefix(operation, prefix) {
+				return true
+			}
+		} else if operation == v {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseRetryableErrors parses the value of the RetryableErrors column, a
+// semicolon-separated list of `Operations:Code:Message` where Operations is a
+// space-separated list which may be empty and the Message is optional.
+// There is no escaping: messages cannot contain ";" and any ":" after the Code
+// is part of the Message. Stray whitespace is rejected.
+func ParseRetryableErrors(s string) ([]RetryableError, error) {
+	var retryableErrors []RetryableError
+
+	if s == "" {
+		return retryableErrors, nil
+	}
+
+	for _, v := range strings.Split(s, ";") {
+		parts := strings.SplitN(v, ":", 3)
+
+		if len(parts) < 2 || parts[1] == "" {
+			return nil, fmt.Errorf("retryable error (%s) must be in the form Operations:Code:Message", v)
+		}
+
+		if strings.TrimSpace(v) != v {
+			return nil, fmt.Errorf("retryable error (%s) must not have leading or trailing whitespace", v)
+		}
+
+		operations := strings.Fields(parts[0])
+
+		if strings.Join(operations, " ") != parts[0] {
+			return nil, fmt.Errorf("retryable error (%s) operations must be separated by a single space", v)
+		}
+
+		if strings.ContainsAny(parts[1], " \t") {
+			return nil, fmt.Errorf("retryable error (%s) code must not contain whitespace", v)
+		}
+
+		e := RetryableError{
+			Code: parts[1],
+		}
+
+		if len(operations) > 0 {
+			e.Operations = operations
+		}
+
+		if len(parts) == 3 {
+			if strings.TrimSpace(parts[2]) != parts[2] {
+				return nil, fmt.Errorf("retryable error (%s) message must not have leading or trailing whitespace", v)
+			}
+
+			e.Message = parts[2]
+		}
+
+		retryableErrors = append(retryableErrors, e)
+	}
+
+	return retryableErrors, nil
+}
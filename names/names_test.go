@@ -3,9 +3,52 @@ package names
 import (
 	"fmt"
 	"os"
+	"reflect"
 	"testing"
 )
 
+func TestProviderPackagesForEndpointsID(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected []string
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			TestName: "same as provider package",
+			Input:    "sqs",
+			Expected: []string{SQS},
+		},
+		{
+			TestName: "different from provider package",
+			Input:    "monitoring",
+			Expected: []string{CloudWatch},
+		},
+		{
+			TestName: "shared",
+			Input:    "elasticloadbalancing",
+			Expected: []string{ELB, ELBV2},
+		},
+		{
+			TestName: "unknown",
+			Input:    "notaservice",
+			Expected: nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := ProviderPackagesForEndpointsID(testCase.Input); !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestProviderPackageForAlias(t *testing.T) {
 	testCases := []struct {
 		TestName string
@@ -281,6 +324,58 @@ func TestProviderNameUpper(t *testing.T) {
 	}
 }
 
+func TestHumanFriendly(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+			Error:    true,
+		},
+		{
+			TestName: Transcribe,
+			Input:    Transcribe,
+			Expected: "Transcribe",
+			Error:    false,
+		},
+		{
+			TestName: "alias",
+			Input:    "cloudwatchevidently",
+			Expected: "CloudWatch Evidently",
+			Error:    false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := HumanFriendly(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
 func TestFullHumanFriendly(t *testing.T) {
 	testCases := []struct {
 		TestName string
@@ -466,3 +561,201 @@ func TestAWSGoV1ClientName(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSGoSDKVersion(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: "",
+			Error:    true,
+		},
+		{
+			TestName: Lambda,
+			Input:    Lambda,
+			Expected: "1",
+			Error:    false,
+		},
+		{
+			TestName: Kendra,
+			Input:    Kendra,
+			Expected: "2",
+			Error:    false,
+		},
+		{
+			TestName: "doesnotexist",
+			Input:    "doesnotexist",
+			Expected: "",
+			Error:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := AWSGoSDKVersion(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestParseRetryableErrors(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected []RetryableError
+		Error    bool
+	}{
+		{
+			TestName: "empty",
+			Input:    "",
+			Expected: nil,
+			Error:    false,
+		},
+		{
+			TestName: "code",
+			Input:    ":ConflictException",
+			Expected: []RetryableError{{Code: "ConflictException"}},
+			Error:    false,
+		},
+		{
+			TestName: "multiple",
+			Input:    "PutItem DeleteItem:LimitExceededException:Subscriber limit exceeded:;Describe*:FailedResourceAccessException:",
+			Expected: []RetryableError{
+				{Operations: []string{"PutItem", "DeleteItem"}, Code: "LimitExceededException", Message: "Subscriber limit exceeded:"},
+				{Operations: []string{"Describe*"}, Code: "FailedResourceAccessException"},
+			},
+			Error: false,
+		},
+		{
+			TestName: "no code",
+			Input:    "PutItem",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "empty code",
+			Input:    "PutItem::message",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "leading whitespace",
+			Input:    ":ConflictException; PutItem:LimitExceededException",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "trailing whitespace",
+			Input:    ":ConflictException:try again later ",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "message leading whitespace",
+			Input:    "PutItem:LimitExceededException: Subscriber limit exceeded",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "operations separator",
+			Input:    "PutItem  DeleteItem:LimitExceededException",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "code whitespace",
+			Input:    "PutItem: LimitExceededException",
+			Expected: nil,
+			Error:    true,
+		},
+		{
+			TestName: "message spaces",
+			Input:    "PutItem:LimitExceededException:Subscriber limit exceeded",
+			Expected: []RetryableError{{Operations: []string{"PutItem"}, Code: "LimitExceededException", Message: "Subscriber limit exceeded"}},
+			Error:    false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := ParseRetryableErrors(testCase.Input)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%v) and no error, expected error", got)
+			}
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestRetryableErrorMatchesOperation(t *testing.T) {
+	testCases := []struct {
+		TestName   string
+		Operations []string
+		Input      string
+		Expected   bool
+	}{
+		{
+			TestName: "all operations",
+			Input:    "CreateStream",
+			Expected: true,
+		},
+		{
+			TestName:   "name",
+			Operations: []string{"CreateStream", "DeleteStream"},
+			Input:      "DeleteStream",
+			Expected:   true,
+		},
+		{
+			TestName:   "name mismatch",
+			Operations: []string{"CreateStream"},
+			Input:      "CreateStreamConsumer",
+			Expected:   false,
+		},
+		{
+			TestName:   "prefix",
+			Operations: []string{"Describe*", "List*"},
+			Input:      "ListScalingPolicies",
+			Expected:   true,
+		},
+		{
+			TestName:   "prefix mismatch",
+			Operations: []string{"Describe*", "List*"},
+			Input:      "PutScalingPolicy",
+			Expected:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			e := RetryableError{Operations: testCase.Operations, Code: "LimitExceededException"}
+
+			if got := e.MatchesOperation(testCase.Input); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package tfresource
+
+const (
+	FuncNameNotFound = `NotFound`
+)
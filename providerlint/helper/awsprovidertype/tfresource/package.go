@@ -0,0 +1,19 @@
+package tfresource
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+)
+
+const (
+	PackageName = `tfresource`
+	// PackagePath is matched as an import path suffix
+	PackagePath = `internal/tfresource`
+)
+
+// IsFunc returns if the function call is in the package
+func IsFunc(e ast.Expr, info *types.Info, funcName string) bool {
+	return astutils.IsPackageFunc(e, info, PackagePath, funcName)
+}
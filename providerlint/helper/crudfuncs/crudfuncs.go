@@ -0,0 +1,164 @@
+// Package crudfuncs finds the CRUD functions of managed resources.
+package crudfuncs
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"golang.org/x/tools/go/analysis"
+)
+
+var (
+	CreateFieldNames = []string{
+		schema.ResourceFieldCreate,
+		schema.ResourceFieldCreateContext,
+		schema.ResourceFieldCreateWithoutTimeout,
+	}
+	ReadFieldNames = []string{
+		schema.ResourceFieldRead,
+		schema.ResourceFieldReadContext,
+		schema.ResourceFieldReadWithoutTimeout,
+	}
+)
+
+// IsResource returns true if the schema.Resource literal is a managed resource,
+// i.e. declares any Create function. Unlike (*schema.ResourceInfo).IsResource(),
+// this includes resources implementing CreateContext or CreateWithoutTimeout.
+func IsResource(info *schema.ResourceInfo) bool {
+	for _, fieldName := range CreateFieldNames {
+		if info.DeclaresField(fieldName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Funcs returns the *ast.FuncDecl or *ast.FuncLit nodes assigned to any of the fields
+// of managed resource literals. Functions declared outside the package are skipped.
+func Funcs(pass *analysis.Pass, resourceInfos []*schema.ResourceInfo, fieldNames []string) []ast.Node {
+	var result []ast.Node
+
+	seen := make(map[ast.Node]bool)
+
+	for _, resourceInfo := range resourceInfos {
+		if !IsResource(resourceInfo) {
+			continue
+		}
+
+		for _, fieldName := range fieldNames {
+			kvExpr := resourceInfo.Fields[fieldName]
+
+			if kvExpr == nil {
+				continue
+			}
+
+			var node ast.Node
+
+			switch v := kvExpr.Value.(type) {
+			case *ast.FuncLit:
+				node = v
+			default:
+				if funcDecl := FuncDecl(pass, v); funcDecl != nil {
+					node = funcDecl
+				}
+			}
+
+			if node == nil || seen[node] {
+				continue
+			}
+
+			seen[node] = true
+			result = append(result, node)
+		}
+	}
+
+	return result
+}
+
+// FuncDecl returns the declaration, in the package being analyzed, of the function
+// referenced by the expression or nil if not found.
+func FuncDecl(pass *analysis.Pass, e ast.Expr) *ast.FuncDecl {
+	ident, ok := e.(*ast.Ident)
+
+	if !ok {
+		return nil
+	}
+
+	obj, ok := pass.TypesInfo.ObjectOf(ident).(*types.Func)
+
+	if !ok {
+		return nil
+	}
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+
+			if !ok || funcDecl.Recv != nil {
+				continue
+			}
+
+			if pass.TypesInfo.Defs[funcDecl.Name] == obj {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
+
+// Body returns the body of an *ast.FuncDecl or *ast.FuncLit.
+func Body(node ast.Node) *ast.BlockStmt {
+	switch v := node.(type) {
+	case *ast.FuncDecl:
+		return v.Body
+	case *ast.FuncLit:
+		return v.Body
+	}
+
+	return nil
+}
+
+// IsResourceDataSetIdCall returns true if the call is (*schema.ResourceData).SetId().
+// If empty is true, the call must also be removing the resource from state, i.e. SetId("").
+func IsResourceDataSetIdCall(pass *analysis.Pass, callExpr *ast.CallExpr, empty bool) bool {
+	if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "SetId") {
+		return false
+	}
+
+	if !empty {
+		return true
+	}
+
+	if len(callExpr.Args) != 1 {
+		return false
+	}
+
+	value := astutils.ExprStringValue(callExpr.Args[0])
+
+	return value != nil && *value == ""
+}
+
+// ContainsResourceDataIsNewResourceCall returns true if the node contains a call to (*schema.ResourceData).IsNewResource().
+func ContainsResourceDataIsNewResourceCall(pass *analysis.Pass, node ast.Node) bool {
+	var found bool
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return !found
+		}
+
+		if schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "IsNewResource") {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
@@ -0,0 +1,129 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/token"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/awsprovidertype/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/crudfuncs"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Read tfresource.NotFound() handling without d.IsNewResource()
+
+The AWSR003 analyzer reports when a resource Read function checks for a
+tfresource.NotFound() error and then returns or removes the resource from
+state without checking (*schema.ResourceData).IsNewResource().
+
+Immediately after Create, a resource not being found is typically caused by
+eventual consistency and should return an error rather than silently
+removing the resource from state.
+`
+
+const analyzerName = "AWSR003"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+
+	for _, readFunc := range crudfuncs.Funcs(pass, resourceInfos, crudfuncs.ReadFieldNames) {
+		inspectIfStmts(pass, commentIgnorer, crudfuncs.Body(readFunc), false)
+	}
+
+	return nil, nil
+}
+
+// inspectIfStmts walks the node, reporting any unguarded tfresource.NotFound() if statements.
+// guarded is true once an enclosing if statement has checked d.IsNewResource().
+func inspectIfStmts(pass *analysis.Pass, commentIgnorer *commentignore.Ignorer, node ast.Node, guarded bool) {
+	if node == nil {
+		return
+	}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			// Nested functions, e.g. retry functions, are not the Read result.
+			return false
+		case *ast.IfStmt:
+			if n.Init != nil {
+				inspectIfStmts(pass, commentIgnorer, n.Init, guarded)
+			}
+
+			ifGuarded := guarded || crudfuncs.ContainsResourceDataIsNewResourceCall(pass, n.Cond)
+
+			if !ifGuarded && containsNotFoundCall(pass, n.Cond) && !commentIgnorer.ShouldIgnore(analyzerName, n) {
+				if !crudfuncs.ContainsResourceDataIsNewResourceCall(pass, n.Body) && removesOrReturns(pass, n.Body) {
+					pass.Reportf(n.Pos(), "%s: check !d.IsNewResource() before handling tfresource.NotFound() in Read", analyzerName)
+				}
+			}
+
+			inspectIfStmts(pass, commentIgnorer, n.Body, ifGuarded)
+
+			if n.Else != nil {
+				inspectIfStmts(pass, commentIgnorer, n.Else, ifGuarded)
+			}
+
+			return false
+		}
+
+		return true
+	})
+}
+
+// containsNotFoundCall returns true if the expression contains a non-negated tfresource.NotFound() call.
+func containsNotFoundCall(pass *analysis.Pass, e ast.Expr) bool {
+	var found bool
+
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			if n.Op == token.NOT {
+				return false
+			}
+		case *ast.CallExpr:
+			if tfresource.IsFunc(n.Fun, pass.TypesInfo, tfresource.FuncNameNotFound) {
+				found = true
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// removesOrReturns returns true if the block removes the resource from state or returns.
+func removesOrReturns(pass *analysis.Pass, body *ast.BlockStmt) bool {
+	var found bool
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			found = true
+		case *ast.CallExpr:
+			if crudfuncs.IsResourceDataSetIdCall(pass, n, true) {
+				found = true
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
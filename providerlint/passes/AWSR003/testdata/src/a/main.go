@@ -0,0 +1,142 @@
+package a
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"a/internal/tfresource"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func find() error {
+	return errors.New("not found")
+}
+
+func f() {
+	/* Passing cases */
+	_ = &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourcePassingRead,
+	}
+
+	_ = &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourcePassingNestedRead,
+	}
+
+	_ = &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourcePassingNegatedRead,
+	}
+
+	// Data sources should return errors.
+	_ = &schema.Resource{
+		Read: dataSourceRead,
+	}
+
+	/* Comment ignored cases */
+	_ = &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceIgnoredRead,
+	}
+
+	/* Failing cases */
+	_ = &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceFailingRemoveRead,
+	}
+
+	_ = &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceFailingErrorRead,
+	}
+}
+
+func resourcePassingCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return resourcePassingRead(d, meta)
+}
+
+func resourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	err := find()
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Resource (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourcePassingNestedRead(d *schema.ResourceData, meta interface{}) error {
+	err := find()
+
+	if tfresource.NotFound(err) {
+		if d.IsNewResource() {
+			return fmt.Errorf("error reading Resource (%s): not found after creation", d.Id())
+		}
+
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourcePassingNegatedRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); err != nil && !tfresource.NotFound(err) {
+		return fmt.Errorf("error reading Resource (%s) policy: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func dataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); tfresource.NotFound(err) {
+		return fmt.Errorf("error reading Data Source: %w", err)
+	}
+
+	return nil
+}
+
+func resourceIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	err := find()
+
+	//lintignore:AWSR003
+	if tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceFailingRemoveRead(d *schema.ResourceData, meta interface{}) error {
+	err := find()
+
+	if tfresource.NotFound(err) { // want "check !d.IsNewResource\\(\\) before handling tfresource.NotFound\\(\\) in Read"
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceFailingErrorRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); tfresource.NotFound(err) { // want "check !d.IsNewResource\\(\\) before handling tfresource.NotFound\\(\\) in Read"
+		return fmt.Errorf("error reading Resource (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
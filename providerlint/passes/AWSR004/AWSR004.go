@@ -0,0 +1,113 @@
+package AWSR004
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/crudfuncs"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Read d.SetId("") without log.Printf() warning
+
+The AWSR004 analyzer reports when a resource Read function removes the
+resource from state via (*schema.ResourceData).SetId("") without a
+log.Printf() call with a [WARN] message in the same block.
+
+Removing a resource from state is otherwise silent, which makes unexpected
+resource recreation difficult to troubleshoot.
+`
+
+const analyzerName = "AWSR004"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+
+	for _, readFunc := range crudfuncs.Funcs(pass, resourceInfos, crudfuncs.ReadFieldNames) {
+		ast.Inspect(crudfuncs.Body(readFunc), func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.BlockStmt:
+				checkStmts(pass, commentIgnorer, n.List)
+			case *ast.CaseClause:
+				checkStmts(pass, commentIgnorer, n.Body)
+			case *ast.CommClause:
+				checkStmts(pass, commentIgnorer, n.Body)
+			}
+
+			return true
+		})
+	}
+
+	return nil, nil
+}
+
+func checkStmts(pass *analysis.Pass, commentIgnorer *commentignore.Ignorer, stmts []ast.Stmt) {
+	var setIdCallExprs []*ast.CallExpr
+	var warningFound bool
+
+	for _, stmt := range stmts {
+		exprStmt, ok := stmt.(*ast.ExprStmt)
+
+		if !ok {
+			continue
+		}
+
+		callExpr, ok := exprStmt.X.(*ast.CallExpr)
+
+		if !ok {
+			continue
+		}
+
+		if crudfuncs.IsResourceDataSetIdCall(pass, callExpr, true) {
+			setIdCallExprs = append(setIdCallExprs, callExpr)
+			continue
+		}
+
+		if isLogPrintfWarning(pass, callExpr) {
+			warningFound = true
+		}
+	}
+
+	if warningFound {
+		return
+	}
+
+	for _, callExpr := range setIdCallExprs {
+		if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+			continue
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: log.Printf() a [WARN] message before removing the resource from state with d.SetId(\"\") in Read", analyzerName)
+	}
+}
+
+func isLogPrintfWarning(pass *analysis.Pass, callExpr *ast.CallExpr) bool {
+	if !astutils.IsStdlibPackageFunc(callExpr.Fun, pass.TypesInfo, "log", "Printf") {
+		return false
+	}
+
+	if len(callExpr.Args) == 0 {
+		return false
+	}
+
+	format := astutils.ExprStringValue(callExpr.Args[0])
+
+	return format != nil && strings.HasPrefix(*format, "[WARN]")
+}
@@ -0,0 +1,98 @@
+package a
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func find() error {
+	return errors.New("not found")
+}
+
+func f() {
+	/* Passing cases */
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourcePassingRead,
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourcePassingSwitchRead,
+	}
+
+	/* Comment ignored cases */
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceIgnoredRead,
+	}
+
+	/* Failing cases */
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceFailingRead,
+	}
+
+	_ = &schema.Resource{
+		Create: resourceCreate,
+		Read:   resourceFailingDebugRead,
+	}
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return nil
+}
+
+func resourcePassingRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); !d.IsNewResource() && err != nil {
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourcePassingSwitchRead(d *schema.ResourceData, meta interface{}) error {
+	switch err := find(); {
+	case !d.IsNewResource() && err != nil:
+		log.Printf("[WARN] Resource (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceIgnoredRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); !d.IsNewResource() && err != nil {
+		//lintignore:AWSR004
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func resourceFailingRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); !d.IsNewResource() && err != nil {
+		d.SetId("") // want "log.Printf\\(\\) a \\[WARN\\] message before removing the resource from state"
+		return nil
+	}
+
+	return nil
+}
+
+func resourceFailingDebugRead(d *schema.ResourceData, meta interface{}) error {
+	if err := find(); !d.IsNewResource() && err != nil {
+		log.Printf("[DEBUG] Resource (%s) not found", d.Id())
+		d.SetId("") // want "log.Printf\\(\\) a \\[WARN\\] message before removing the resource from state"
+		return nil
+	}
+
+	return nil
+}
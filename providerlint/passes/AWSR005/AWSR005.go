@@ -0,0 +1,108 @@
+package AWSR005
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for d.Set() of nested attributes ignoring the error
+
+The AWSR005 analyzer reports when a (*schema.ResourceData).Set() call of a
+nested attribute ignores the returned error. Nested attributes are values
+returned by a package flatten function or slices of maps with string keys,
+e.g. []map[string]interface{}.
+
+Unlike most primitive values, nested values which do not match the schema
+fail to be set, silently losing the attribute from state.
+`
+
+const analyzerName = "AWSR005"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	nodeFilter := []ast.Node{
+		(*ast.AssignStmt)(nil),
+		(*ast.ExprStmt)(nil),
+	}
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var callExpr *ast.CallExpr
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				return
+			}
+
+			if ident, ok := n.Lhs[0].(*ast.Ident); !ok || ident.Name != "_" {
+				return
+			}
+
+			callExpr, _ = n.Rhs[0].(*ast.CallExpr)
+		case *ast.ExprStmt:
+			callExpr, _ = n.X.(*ast.CallExpr)
+		}
+
+		if callExpr == nil || len(callExpr.Args) < 2 {
+			return
+		}
+
+		if !schema.IsReceiverMethod(callExpr.Fun, pass.TypesInfo, schema.TypeNameResourceData, "Set") {
+			return
+		}
+
+		if !isNestedValue(pass, callExpr.Args[1]) {
+			return
+		}
+
+		if commentIgnorer.ShouldIgnore(analyzerName, n) {
+			return
+		}
+
+		pass.Reportf(callExpr.Pos(), "%s: check the error returned by d.Set() of a nested attribute", analyzerName)
+	})
+
+	return nil, nil
+}
+
+func isNestedValue(pass *analysis.Pass, e ast.Expr) bool {
+	if callExpr, ok := e.(*ast.CallExpr); ok {
+		if ident, ok := callExpr.Fun.(*ast.Ident); ok && strings.HasPrefix(ident.Name, "flatten") {
+			return true
+		}
+	}
+
+	slice, ok := pass.TypesInfo.TypeOf(e).(*types.Slice)
+
+	if !ok {
+		return false
+	}
+
+	m, ok := slice.Elem().(*types.Map)
+
+	if !ok {
+		return false
+	}
+
+	key, ok := m.Key().(*types.Basic)
+
+	return ok && key.Kind() == types.String
+}
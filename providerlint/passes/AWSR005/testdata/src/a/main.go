@@ -0,0 +1,42 @@
+package a
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func flattenConfiguration(v string) []interface{} {
+	return []interface{}{map[string]interface{}{"name": v}}
+}
+
+func f(d *schema.ResourceData) error {
+	/* Passing cases */
+	d.Set("name", "test")
+
+	d.Set("names", []interface{}{"test"})
+
+	if err := d.Set("configuration", flattenConfiguration("test")); err != nil {
+		return fmt.Errorf("error setting configuration: %w", err)
+	}
+
+	if err := d.Set("rule", []map[string]interface{}{{"name": "test"}}); err != nil {
+		return fmt.Errorf("error setting rule: %w", err)
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR005
+	d.Set("configuration", flattenConfiguration("test"))
+
+	d.Set("configuration", flattenConfiguration("test")) //lintignore:AWSR005
+
+	/* Failing cases */
+	d.Set("configuration", flattenConfiguration("test")) // want "check the error returned by d.Set\\(\\) of a nested attribute"
+
+	_ = d.Set("configuration", flattenConfiguration("test")) // want "check the error returned by d.Set\\(\\) of a nested attribute"
+
+	d.Set("rule", []map[string]interface{}{{"name": "test"}}) // want "check the error returned by d.Set\\(\\) of a nested attribute"
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package AWSR006
+
+import (
+	"go/ast"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"github.com/hashicorp/terraform-provider-aws/providerlint/helper/crudfuncs"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for Create functions missing d.SetId()
+
+The AWSR006 analyzer reports when a resource Create function never calls
+(*schema.ResourceData).SetId(), either directly or in a package function
+it passes the *schema.ResourceData to.
+
+Without an ID, Terraform discards the resource after a successful Create,
+leaving the created infrastructure unmanaged.
+`
+
+const analyzerName = "AWSR006"
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+
+	for _, createFunc := range crudfuncs.Funcs(pass, resourceInfos, crudfuncs.CreateFieldNames) {
+		if commentIgnorer.ShouldIgnore(analyzerName, createFunc) {
+			continue
+		}
+
+		if callsSetId(pass, createFunc, make(map[ast.Node]bool)) {
+			continue
+		}
+
+		pass.Reportf(createFunc.Pos(), "%s: Create should call d.SetId()", analyzerName)
+	}
+
+	return nil, nil
+}
+
+// callsSetId returns true if the function, or any package function it passes
+// the *schema.ResourceData to, calls (*schema.ResourceData).SetId().
+func callsSetId(pass *analysis.Pass, node ast.Node, visited map[ast.Node]bool) bool {
+	if visited[node] {
+		return false
+	}
+
+	visited[node] = true
+
+	var found bool
+
+	ast.Inspect(crudfuncs.Body(node), func(n ast.Node) bool {
+		callExpr, ok := n.(*ast.CallExpr)
+
+		if !ok {
+			return !found
+		}
+
+		if crudfuncs.IsResourceDataSetIdCall(pass, callExpr, false) {
+			found = true
+			return false
+		}
+
+		if !passesResourceData(pass, callExpr) {
+			return true
+		}
+
+		if funcDecl := crudfuncs.FuncDecl(pass, callExpr.Fun); funcDecl != nil && callsSetId(pass, funcDecl, visited) {
+			found = true
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+func passesResourceData(pass *analysis.Pass, callExpr *ast.CallExpr) bool {
+	for _, arg := range callExpr.Args {
+		if schema.IsTypeResourceData(pass.TypesInfo.TypeOf(arg)) {
+			return true
+		}
+	}
+
+	return false
+}
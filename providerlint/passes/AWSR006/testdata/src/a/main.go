@@ -0,0 +1,107 @@
+package a
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	/* Passing cases */
+	_ = &schema.Resource{
+		Create: resourcePassingCreate,
+		Read:   resourceRead,
+	}
+
+	_ = &schema.Resource{
+		CreateWithoutTimeout: resourcePassingContextCreate,
+		ReadWithoutTimeout:   resourceContextRead,
+	}
+
+	_ = &schema.Resource{
+		Create: resourcePassingDelegatedCreate,
+		Read:   resourceRead,
+	}
+
+	_ = &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			d.SetId("test")
+
+			return nil
+		},
+		Read: resourceRead,
+	}
+
+	// Read only functions are not checked.
+	_ = &schema.Resource{
+		Read: resourceRead,
+	}
+
+	/* Comment ignored cases */
+	_ = &schema.Resource{
+		Create: resourceIgnoredCreate,
+		Read:   resourceRead,
+	}
+
+	/* Failing cases */
+	_ = &schema.Resource{
+		Create: resourceFailingCreate,
+		Read:   resourceRead,
+	}
+
+	_ = &schema.Resource{
+		CreateWithoutTimeout: resourceFailingContextCreate,
+		ReadWithoutTimeout:   resourceContextRead,
+	}
+
+	_ = &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error { // want "Create should call d.SetId\\(\\)"
+			return nil
+		},
+		Read: resourceRead,
+	}
+}
+
+func resourceRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceContextRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourcePassingCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return resourceRead(d, meta)
+}
+
+func resourcePassingContextCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("test")
+
+	return resourceContextRead(ctx, d, meta)
+}
+
+func resourcePassingDelegatedCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourcePut(d, meta)
+}
+
+func resourcePut(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("test")
+
+	return resourceRead(d, meta)
+}
+
+//lintignore:AWSR006
+func resourceIgnoredCreate(d *schema.ResourceData, meta interface{}) error {
+	return resourceRead(d, meta)
+}
+
+func resourceFailingCreate(d *schema.ResourceData, meta interface{}) error { // want "Create should call d.SetId\\(\\)"
+	return resourceRead(d, meta)
+}
+
+func resourceFailingContextCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { // want "Create should call d.SetId\\(\\)"
+	return resourceContextRead(ctx, d, meta)
+}
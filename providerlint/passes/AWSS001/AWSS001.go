@@ -0,0 +1,327 @@
+package AWSS001
+
+import (
+	"go/ast"
+	"sort"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/astutils"
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for resource and data source schema inconsistencies
+
+The AWSS001 analyzer compares the schema of each resource with the data
+source of the same name in the package, e.g. ResourceFunction() and
+DataSourceFunction(), and reports:
+
+- resource attributes missing from the data source, other than tags_all,
+  Sensitive attributes and write-only arguments such as force_destroy
+- attributes with a different Type in the data source
+- ForceNew in the data source, which is never replaced
+- ForceNew on Computed-only resource attributes, which cannot be configured
+
+Nested blocks are compared when both schemas declare them inline. Schemas
+which are not map[string]*schema.Schema literals are skipped.
+`
+
+const analyzerName = "AWSS001"
+
+const (
+	dataSourceFuncPrefix = "DataSource"
+	resourceFuncPrefix   = "Resource"
+)
+
+// writeOnlyArguments are the names of resource arguments which only control how changes are applied, e.g. on
+// deletion, or the generated name, and are never read from AWS, so are not expected in data sources.
+var writeOnlyArguments = map[string]bool{
+	"allow_major_version_upgrade":        true,
+	"apply_immediately":                  true,
+	"bucket_prefix":                      true,
+	"bypass_policy_lockout_safety_check": true,
+	"cluster_identifier_prefix":          true,
+	"compute_environment_name_prefix":    true,
+	"delete_automated_backups":           true,
+	"deletion_window_in_days":            true,
+	"enforce_consumer_deletion":          true,
+	"final_snapshot_identifier":          true,
+	"force_delete":                       true,
+	"force_delete_warm_pool":             true,
+	"force_destroy":                      true,
+	"force_detach_policies":              true,
+	"force_new_deployment":               true,
+	"force_overwrite_replica_secret":     true,
+	"force_update_version":               true,
+	"identifier_prefix":                  true,
+	"key_name_prefix":                    true,
+	"name_prefix":                        true,
+	"node_group_name_prefix":             true,
+	"recovery_window_in_days":            true,
+	"retain_on_delete":                   true,
+	"revoke_rules_on_delete":             true,
+	"skip_destroy":                       true,
+	"skip_final_snapshot":                true,
+	"user_data_replace_on_change":        true,
+	"wait_for_capacity_timeout":          true,
+	"wait_for_deployment":                true,
+	"wait_for_elb_capacity":              true,
+	"wait_for_steady_state":              true,
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+
+	funcDecls := make(map[string]*ast.FuncDecl)
+
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+				funcDecls[funcDecl.Name.Name] = funcDecl
+			}
+		}
+	}
+
+	var names []string
+
+	for name := range funcDecls {
+		if strings.HasPrefix(name, dataSourceFuncPrefix) {
+			names = append(names, strings.TrimPrefix(name, dataSourceFuncPrefix))
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		dataSourceFuncDecl := funcDecls[dataSourceFuncPrefix+name]
+		resourceFuncDecl, ok := funcDecls[resourceFuncPrefix+name]
+
+		if !ok {
+			continue
+		}
+
+		// An ignore comment on either function would also ignore differences added later.
+		if ignored := ignoredFuncDecl(commentIgnorer, dataSourceFuncDecl, resourceFuncDecl); ignored != nil {
+			pass.Reportf(ignored.Pos(), "%s: ignore differences between %s and %s per attribute, not for the whole function", analyzerName, resourceFuncDecl.Name.Name, dataSourceFuncDecl.Name.Name)
+			continue
+		}
+
+		dataSourceSchema := returnedSchemaMap(pass, dataSourceFuncDecl)
+		resourceSchema := returnedSchemaMap(pass, resourceFuncDecl)
+
+		if dataSourceSchema == nil || resourceSchema == nil {
+			continue
+		}
+
+		c := &comparer{
+			commentIgnorer: commentIgnorer,
+			dataSource:     dataSourceFuncDecl.Name.Name,
+			pass:           pass,
+		}
+
+		c.compare("", resourceSchema, dataSourceSchema)
+	}
+
+	return nil, nil
+}
+
+// ignoredFuncDecl returns the first of the function declarations with an ignore comment, or nil.
+func ignoredFuncDecl(commentIgnorer *commentignore.Ignorer, funcDecls ...*ast.FuncDecl) *ast.FuncDecl {
+	for _, funcDecl := range funcDecls {
+		if commentIgnorer.ShouldIgnore(analyzerName, funcDecl) {
+			return funcDecl
+		}
+	}
+
+	return nil
+}
+
+type comparer struct {
+	commentIgnorer *commentignore.Ignorer
+	dataSource     string
+	pass           *analysis.Pass
+}
+
+func (c *comparer) report(node ast.Node, format string, args ...interface{}) {
+	if c.commentIgnorer.ShouldIgnore(analyzerName, node) {
+		return
+	}
+
+	c.pass.Reportf(node.Pos(), "%s: "+format, append([]interface{}{analyzerName}, args...)...)
+}
+
+// compare reports inconsistencies between the resource and data source schema map literals.
+// Attribute names are reported with the path of any enclosing blocks.
+func (c *comparer) compare(path string, resourceSchema, dataSourceSchema *ast.CompositeLit) {
+	resourceAttributes := schemaMapAttributes(c.pass, resourceSchema)
+	dataSourceAttributes := make(map[string]*attribute)
+
+	for _, dataSourceAttribute := range schemaMapAttributes(c.pass, dataSourceSchema) {
+		dataSourceAttributes[dataSourceAttribute.name] = dataSourceAttribute
+
+		if dataSourceAttribute.schema != nil && dataSourceAttribute.schema.Schema.ForceNew {
+			c.report(dataSourceAttribute.kvExpr, "%s attribute %q should not be ForceNew", c.dataSource, path+dataSourceAttribute.name)
+		}
+	}
+
+	for _, resourceAttribute := range resourceAttributes {
+		name := path + resourceAttribute.name
+		resourceSchemaInfo := resourceAttribute.schema
+
+		if resourceSchemaInfo != nil {
+			s := resourceSchemaInfo.Schema
+
+			if s.ForceNew && s.Computed && !s.Optional && !s.Required {
+				c.report(resourceAttribute.kvExpr, "resource attribute %q is Computed-only and should not be ForceNew", name)
+			}
+
+			if s.Sensitive {
+				continue
+			}
+		}
+
+		if resourceAttribute.name == "tags_all" || writeOnlyArguments[resourceAttribute.name] {
+			continue
+		}
+
+		dataSourceAttribute, ok := dataSourceAttributes[resourceAttribute.name]
+
+		if !ok {
+			c.report(resourceAttribute.kvExpr, "resource attribute %q missing from %s", name, c.dataSource)
+			continue
+		}
+
+		dataSourceSchemaInfo := dataSourceAttribute.schema
+
+		if resourceSchemaInfo == nil || dataSourceSchemaInfo == nil {
+			continue
+		}
+
+		resourceType, dataSourceType := resourceSchemaInfo.SchemaValueType, dataSourceSchemaInfo.SchemaValueType
+
+		if resourceType != "" && dataSourceType != "" && resourceType != dataSourceType {
+			c.report(dataSourceAttribute.kvExpr, "%s attribute %q is %s, resource is %s", c.dataSource, name, dataSourceType, resourceType)
+			continue
+		}
+
+		resourceBlock, dataSourceBlock := elemSchemaMap(resourceSchemaInfo), elemSchemaMap(dataSourceSchemaInfo)
+
+		if resourceBlock != nil && dataSourceBlock != nil {
+			c.compare(name+".", resourceBlock, dataSourceBlock)
+		}
+	}
+}
+
+type attribute struct {
+	kvExpr *ast.KeyValueExpr
+	name   string
+	schema *schema.SchemaInfo // nil if not a schema.Schema literal
+}
+
+// schemaMapAttributes returns the attributes of a map[string]*schema.Schema literal in source order.
+func schemaMapAttributes(pass *analysis.Pass, cl *ast.CompositeLit) []*attribute {
+	var attributes []*attribute
+
+	for _, elt := range cl.Elts {
+		kvExpr, ok := elt.(*ast.KeyValueExpr)
+
+		if !ok {
+			continue
+		}
+
+		name := astutils.ExprStringValue(kvExpr.Key)
+
+		if name == nil {
+			continue
+		}
+
+		attribute := &attribute{
+			kvExpr: kvExpr,
+			name:   *name,
+		}
+
+		if cl, ok := kvExpr.Value.(*ast.CompositeLit); ok {
+			attribute.schema = schema.NewSchemaInfo(cl, pass.TypesInfo)
+		}
+
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes
+}
+
+// returnedSchemaMap returns the Schema map literal of a function returning a &schema.Resource{} literal.
+func returnedSchemaMap(pass *analysis.Pass, funcDecl *ast.FuncDecl) *ast.CompositeLit {
+	if funcDecl.Body == nil || len(funcDecl.Body.List) == 0 {
+		return nil
+	}
+
+	returnStmt, ok := funcDecl.Body.List[len(funcDecl.Body.List)-1].(*ast.ReturnStmt)
+
+	if !ok || len(returnStmt.Results) != 1 {
+		return nil
+	}
+
+	unaryExpr, ok := returnStmt.Results[0].(*ast.UnaryExpr)
+
+	if !ok {
+		return nil
+	}
+
+	cl, ok := unaryExpr.X.(*ast.CompositeLit)
+
+	if !ok || !schema.IsTypeResource(pass.TypesInfo.TypeOf(cl.Type)) {
+		return nil
+	}
+
+	return resourceSchemaMap(cl)
+}
+
+// elemSchemaMap returns the Schema map literal of an Elem: &schema.Resource{} literal.
+func elemSchemaMap(schemaInfo *schema.SchemaInfo) *ast.CompositeLit {
+	kvExpr := schemaInfo.Fields[schema.SchemaFieldElem]
+
+	if kvExpr == nil {
+		return nil
+	}
+
+	unaryExpr, ok := kvExpr.Value.(*ast.UnaryExpr)
+
+	if !ok {
+		return nil
+	}
+
+	cl, ok := unaryExpr.X.(*ast.CompositeLit)
+
+	if !ok {
+		return nil
+	}
+
+	return resourceSchemaMap(cl)
+}
+
+func resourceSchemaMap(cl *ast.CompositeLit) *ast.CompositeLit {
+	kvExpr := astutils.CompositeLitField(cl, schema.ResourceFieldSchema)
+
+	if kvExpr == nil {
+		return nil
+	}
+
+	schemaMap, ok := kvExpr.Value.(*ast.CompositeLit)
+
+	if !ok {
+		return nil
+	}
+
+	return schemaMap
+}
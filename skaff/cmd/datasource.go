@@ -5,11 +5,13 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var plural bool
+
 var datasourceCmd = &cobra.Command{
 	Use:   "datasource",
 	Short: "Create scaffolding for a data source",
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return datasource.Create(name, snakeName, !clearComments, force)
+		return datasource.Create(name, snakeName, !clearComments, force, plural, register)
 	},
 }
 
@@ -19,4 +21,6 @@ func init() {
 	datasourceCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "Do not include instructional comments in source")
 	datasourceCmd.Flags().StringVarP(&name, "name", "n", "", "Name of the entity")
 	datasourceCmd.Flags().BoolVarP(&force, "force", "f", false, "Force creation, overwriting existing files")
+	datasourceCmd.Flags().BoolVarP(&plural, "plural", "p", false, "Create a plural data source that lists entities, with filters and pagination (e.g., DBInstances)")
+	datasourceCmd.Flags().BoolVarP(&register, "register", "r", false, "Register the data source in the provider's DataSourcesMap")
 }
@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/sweeper"
+	"github.com/spf13/cobra"
+)
+
+var sweeperCmd = &cobra.Command{
+	Use:   "sweeper",
+	Short: "Create scaffolding for a resource sweeper in the service's sweep.go",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return sweeper.Create(name, snakeName, !clearComments)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(sweeperCmd)
+	sweeperCmd.Flags().StringVarP(&snakeName, "snakename", "s", "", "If skaff doesn't get it right, explicitly give name in snake case (e.g., db_vpc_instance)")
+	sweeperCmd.Flags().BoolVarP(&clearComments, "clear-comments", "c", false, "Do not include instructional comments in source")
+	sweeperCmd.Flags().StringVarP(&name, "name", "n", "", "Name of the resource to sweep")
+}
@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/skaff/tags"
+	"github.com/spf13/cobra"
+)
+
+var (
+	serviceTagsMap bool
+	tagsArgs       string
+)
+
+var tagsCmd = &cobra.Command{
+	Use:   "tags",
+	Short: "Add the tags generator directive to the service's generate.go",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return tags.Create(serviceTagsMap, tagsArgs, force)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tagsCmd)
+	tagsCmd.Flags().BoolVarP(&serviceTagsMap, "map", "m", false, "Service uses a map of tags (-ServiceTagsMap) rather than a slice of Tag (-ServiceTagsSlice)")
+	tagsCmd.Flags().StringVarP(&tagsArgs, "args", "a", "", "Additional arguments for the tags generator (e.g., \"-ListTagsOp=ListTags\")")
+	tagsCmd.Flags().BoolVarP(&force, "force", "f", false, "Force creation, replacing an existing tags generator directive")
+}
@@ -11,6 +11,7 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/provider"
 )
 
 //go:embed datasource.tmpl
@@ -22,9 +23,20 @@ var datasourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed pluraldatasource.tmpl
+var pluralDatasourceTmpl string
+
+//go:embed pluraldatasourcetest.tmpl
+var pluralDatasourceTestTmpl string
+
+//go:embed pluralwebsitedoc.tmpl
+var pluralWebsiteTmpl string
+
 type TemplateData struct {
 	DataSource      string
 	DataSourceLower string
+	DataSourceSnake string
+	HumanFriendly   string
 	IncludeComments bool
 	ServicePackage  string
 	Service         string
@@ -44,7 +56,7 @@ func toSnakeCase(upper string, snakeName string) string {
 	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
 }
 
-func Create(dsName, snakeName string, comments, force bool) error {
+func Create(dsName, snakeName string, comments, force, plural, register bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -74,9 +86,16 @@ func Create(dsName, snakeName string, comments, force bool) error {
 		return fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
+	hf, err := names.HumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service human-friendly name: %w", err)
+	}
+
 	templateData := TemplateData{
 		DataSource:      dsName,
 		DataSourceLower: strings.ToLower(dsName),
+		DataSourceSnake: toSnakeCase(dsName, snakeName),
+		HumanFriendly:   hf,
 		IncludeComments: comments,
 		ServicePackage:  servicePackage,
 		Service:         s,
@@ -84,22 +103,39 @@ func Create(dsName, snakeName string, comments, force bool) error {
 		AWSServiceName:  sn,
 	}
 
+	dsTmpl, testTmpl, webTmpl := datasourceTmpl, datasourceTestTmpl, websiteTmpl
+
+	if plural {
+		if !strings.HasSuffix(dsName, "s") {
+			return fmt.Errorf("error checking: plural data source name should be plural (e.g., DBInstances)")
+		}
+
+		dsTmpl, testTmpl, webTmpl = pluralDatasourceTmpl, pluralDatasourceTestTmpl, pluralWebsiteTmpl
+	}
+
 	f := fmt.Sprintf("%s_data_source.go", toSnakeCase(dsName, snakeName))
-	if err = writeTemplate("newds", f, datasourceTmpl, force, templateData); err != nil {
+	if err = writeTemplate("newds", f, dsTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing datasource template: %w", err)
 	}
 
 	tf := fmt.Sprintf("%s_data_source_test.go", toSnakeCase(dsName, snakeName))
-	if err = writeTemplate("dstest", tf, datasourceTestTmpl, force, templateData); err != nil {
+	if err = writeTemplate("dstest", tf, testTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing datasource test template: %w", err)
 	}
 
 	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, toSnakeCase(dsName, snakeName))
 	wf = filepath.Join("..", "..", "..", "website", "docs", "d", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+	if err = writeTemplate("webdoc", wf, webTmpl, force, templateData); err != nil {
 		return fmt.Errorf("writing datasource website doc template: %w", err)
 	}
 
+	if register {
+		typeName := fmt.Sprintf("aws_%s_%s", servicePackage, toSnakeCase(dsName, snakeName))
+		if err = provider.Register(provider.DataSourcesMap, typeName, servicePackage, fmt.Sprintf("DataSource%s", dsName)); err != nil {
+			return fmt.Errorf("registering datasource: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	DataSourcesMap = "DataSourcesMap"
+	ResourcesMap   = "ResourcesMap"
+)
+
+var registrationRegexp = regexp.MustCompile(`^\t\t\t"([^"]+)":\s+(\w+)\.`)
+
+// Register adds the resource or data source to the provider's ResourcesMap or DataSourcesMap,
+// next to the service package's other registrations. It is run from the service package directory.
+func Register(mapName, typeName, servicePackage, function string) error {
+	filename := filepath.Join("..", "..", "provider", "provider.go")
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return fmt.Errorf("error reading provider (%s): %w", filename, err)
+	}
+
+	contents, err := addRegistration(b, mapName, typeName, servicePackage, function)
+	if err != nil {
+		return fmt.Errorf("error registering %s: %w", typeName, err)
+	}
+
+	if err := os.WriteFile(filename, contents, 0644); err != nil {
+		return fmt.Errorf("error writing provider (%s): %w", filename, err)
+	}
+
+	return nil
+}
+
+func addRegistration(src []byte, mapName, typeName, servicePackage, function string) ([]byte, error) {
+	lines := strings.Split(string(src), "\n")
+
+	start, end := -1, -1
+
+	for i, line := range lines {
+		if start == -1 {
+			if strings.TrimSpace(line) == fmt.Sprintf("%s: map[string]*schema.Resource{", mapName) {
+				start = i
+			}
+
+			continue
+		}
+
+		if line == "\t\t}," {
+			end = i
+			break
+		}
+	}
+
+	if start == -1 || end == -1 {
+		return nil, fmt.Errorf("%s not found", mapName)
+	}
+
+	entry := fmt.Sprintf("\t\t\t%q: %s.%s(),", typeName, servicePackage, function)
+	insertAt, last := -1, -1
+
+	for i := start + 1; i < end; i++ {
+		m := registrationRegexp.FindStringSubmatch(lines[i])
+
+		if m == nil {
+			continue
+		}
+
+		if m[1] == typeName {
+			return nil, fmt.Errorf("already registered in %s", mapName)
+		}
+
+		if m[2] != servicePackage {
+			continue
+		}
+
+		if insertAt == -1 && m[1] > typeName {
+			insertAt = i
+		}
+
+		last = i
+	}
+
+	var insert []string
+
+	switch {
+	case insertAt != -1:
+		insert = []string{entry}
+	case last != -1:
+		insertAt = last + 1
+		insert = []string{entry}
+	default:
+		// First registration for the service, in a new group before the first following service.
+		insertAt = end
+		insert = []string{"", entry}
+
+		for i := start + 1; i < end; i++ {
+			if m := registrationRegexp.FindStringSubmatch(lines[i]); m != nil && m[2] > servicePackage && (i == start+1 || lines[i-1] == "") {
+				insertAt = i
+				insert = []string{entry, ""}
+
+				break
+			}
+		}
+
+		lines = addServiceImport(lines, servicePackage, &insertAt)
+	}
+
+	lines = append(lines[:insertAt], append(insert, lines[insertAt:]...)...)
+
+	contents, err := format.Source([]byte(strings.Join(lines, "\n")))
+	if err != nil {
+		return nil, fmt.Errorf("error formatting provider: %w", err)
+	}
+
+	return contents, nil
+}
+
+// addServiceImport adds the service package's import, if missing, adjusting the index after it.
+func addServiceImport(lines []string, servicePackage string, index *int) []string {
+	const prefix = "\t\"github.com/hashicorp/terraform-provider-aws/internal/service/"
+
+	importLine := fmt.Sprintf("%s%s\"", prefix, servicePackage)
+	insertAt, last := -1, -1
+
+	for i, line := range lines {
+		if line == importLine {
+			return lines
+		}
+
+		if !strings.HasPrefix(line, prefix) {
+			continue
+		}
+
+		if insertAt == -1 && line > importLine {
+			insertAt = i
+		}
+
+		last = i
+	}
+
+	if insertAt == -1 {
+		insertAt = last + 1
+	}
+
+	if insertAt <= *index {
+		*index++
+	}
+
+	return append(lines[:insertAt], append([]string{importLine}, lines[insertAt:]...)...)
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"testing"
+)
+
+const testProvider = `package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate": acm.DataSourceCertificate(),
+
+			"aws_mq_broker": mq.DataSourceBroker(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":            acm.ResourceCertificate(),
+			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
+
+			"aws_mq_broker":        mq.ResourceBroker(),
+			"aws_mq_configuration": mq.ResourceConfiguration(),
+		},
+	}
+}
+`
+
+func TestAddRegistration(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		MapName        string
+		TypeName       string
+		ServicePackage string
+		Function       string
+		Expected       string
+		Error          bool
+	}{
+		{
+			TestName:       "existing service",
+			MapName:        ResourcesMap,
+			TypeName:       "aws_mq_broker_reboot",
+			ServicePackage: "mq",
+			Function:       "ResourceBrokerReboot",
+			Expected: `package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate": acm.DataSourceCertificate(),
+
+			"aws_mq_broker": mq.DataSourceBroker(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":            acm.ResourceCertificate(),
+			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
+
+			"aws_mq_broker":        mq.ResourceBroker(),
+			"aws_mq_broker_reboot": mq.ResourceBrokerReboot(),
+			"aws_mq_configuration": mq.ResourceConfiguration(),
+		},
+	}
+}
+`,
+		},
+		{
+			TestName:       "last in service",
+			MapName:        DataSourcesMap,
+			TypeName:       "aws_mq_brokers",
+			ServicePackage: "mq",
+			Function:       "DataSourceBrokers",
+			Expected: `package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate": acm.DataSourceCertificate(),
+
+			"aws_mq_broker":  mq.DataSourceBroker(),
+			"aws_mq_brokers": mq.DataSourceBrokers(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":            acm.ResourceCertificate(),
+			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
+
+			"aws_mq_broker":        mq.ResourceBroker(),
+			"aws_mq_configuration": mq.ResourceConfiguration(),
+		},
+	}
+}
+`,
+		},
+		{
+			TestName:       "new service",
+			MapName:        ResourcesMap,
+			TypeName:       "aws_kendra_index",
+			ServicePackage: "kendra",
+			Function:       "ResourceIndex",
+			Expected: `package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/kendra"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate": acm.DataSourceCertificate(),
+
+			"aws_mq_broker": mq.DataSourceBroker(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":            acm.ResourceCertificate(),
+			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
+
+			"aws_kendra_index": kendra.ResourceIndex(),
+
+			"aws_mq_broker":        mq.ResourceBroker(),
+			"aws_mq_configuration": mq.ResourceConfiguration(),
+		},
+	}
+}
+`,
+		},
+		{
+			TestName:       "new last service",
+			MapName:        DataSourcesMap,
+			TypeName:       "aws_xray_group",
+			ServicePackage: "xray",
+			Function:       "DataSourceGroup",
+			Expected: `package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/mq"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
+)
+
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate": acm.DataSourceCertificate(),
+
+			"aws_mq_broker": mq.DataSourceBroker(),
+
+			"aws_xray_group": xray.DataSourceGroup(),
+		},
+
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_acm_certificate":            acm.ResourceCertificate(),
+			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
+
+			"aws_mq_broker":        mq.ResourceBroker(),
+			"aws_mq_configuration": mq.ResourceConfiguration(),
+		},
+	}
+}
+`,
+		},
+		{
+			TestName:       "already registered",
+			MapName:        ResourcesMap,
+			TypeName:       "aws_mq_broker",
+			ServicePackage: "mq",
+			Function:       "ResourceBroker",
+			Error:          true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := addRegistration([]byte(testProvider), testCase.MapName, testCase.TypeName, testCase.ServicePackage, testCase.Function)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if err == nil && string(got) != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/skaff/provider"
 )
 
 //go:embed resource.tmpl
@@ -44,7 +45,7 @@ func toSnakeCase(upper string, snakeName string) string {
 	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
 }
 
-func Create(resName, snakeName string, comments, force bool) error {
+func Create(resName, snakeName string, comments, force, register bool) error {
 	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
@@ -100,6 +101,13 @@ func Create(resName, snakeName string, comments, force bool) error {
 		return fmt.Errorf("writing resource website doc template: %w", err)
 	}
 
+	if register {
+		typeName := fmt.Sprintf("aws_%s_%s", servicePackage, toSnakeCase(resName, snakeName))
+		if err = provider.Register(provider.ResourcesMap, typeName, servicePackage, fmt.Sprintf("Resource%s", resName)); err != nil {
+			return fmt.Errorf("registering resource: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,264 @@
+package sweeper
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/imports"
+)
+
+//go:embed sweep.tmpl
+var sweepTmpl string
+
+const sweepFile = "sweep.go"
+
+type TemplateData struct {
+	AWSGoSDKV2      bool
+	AWSServiceName  string
+	IncludeComments bool
+	Resource        string
+	ResourceSnake   string
+	SDKPackage      string
+	Service         string
+	ServicePackage  string
+	SweepFunction   string
+}
+
+// imports returns the import paths used by the sweeper.
+func (td TemplateData) imports() []string {
+	sdk := "github.com/aws/aws-sdk-go"
+
+	if td.AWSGoSDKV2 {
+		sdk = "github.com/aws/aws-sdk-go-v2"
+	}
+
+	return []string{
+		"context",
+		"fmt",
+		"log",
+		sdk + "/aws",
+		sdk + "/service/" + td.SDKPackage,
+		"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource",
+		"github.com/hashicorp/terraform-provider-aws/internal/conns",
+		"github.com/hashicorp/terraform-provider-aws/internal/sweep",
+	}
+}
+
+func toSnakeCase(upper string, snakeName string) string {
+	if snakeName != "" {
+		return snakeName
+	}
+
+	re := regexp.MustCompile(`([a-z])([A-Z]{2,})`)
+	upper = re.ReplaceAllString(upper, `${1}_${2}`)
+
+	re2 := regexp.MustCompile(`([A-Z][a-z])`)
+	return strings.TrimPrefix(strings.ToLower(re2.ReplaceAllString(upper, `_$1`)), "_")
+}
+
+func Create(resName, snakeName string, comments bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	if resName == "" {
+		return fmt.Errorf("error checking: no name given")
+	}
+
+	if resName == strings.ToLower(resName) {
+		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+	}
+
+	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
+		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+	}
+
+	s, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting service connection name: %w", err)
+	}
+
+	sn, err := names.FullHumanFriendly(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS service name: %w", err)
+	}
+
+	v, err := names.AWSGoSDKVersion(servicePackage)
+	if err != nil {
+		return fmt.Errorf("error getting AWS SDK for Go version: %w", err)
+	}
+
+	var sdkPackage string
+
+	switch v {
+	case "1":
+		sdkPackage, err = names.AWSGoV1Package(servicePackage)
+	case "2":
+		sdkPackage, err = names.AWSGoV2Package(servicePackage)
+	default:
+		err = fmt.Errorf("unsupported AWS SDK for Go version (%s)", v)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error getting AWS SDK for Go package: %w", err)
+	}
+
+	templateData := TemplateData{
+		AWSGoSDKV2:      v == "2",
+		AWSServiceName:  sn,
+		IncludeComments: comments,
+		Resource:        resName,
+		ResourceSnake:   toSnakeCase(resName, snakeName),
+		SDKPackage:      sdkPackage,
+		Service:         s,
+		ServicePackage:  servicePackage,
+		SweepFunction:   fmt.Sprintf("sweep%ss", resName),
+	}
+
+	var contents []byte
+
+	if b, err := os.ReadFile(sweepFile); os.IsNotExist(err) {
+		contents, err = newSweepFile(templateData)
+
+		if err != nil {
+			return err
+		}
+	} else if err != nil {
+		return fmt.Errorf("error reading file (%s): %w", sweepFile, err)
+	} else {
+		contents, err = addSweeper(b, templateData)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := os.WriteFile(sweepFile, contents, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %w", sweepFile, err)
+	}
+
+	return nil
+}
+
+// newSweepFile returns the contents of a new sweep.go containing the sweeper.
+func newSweepFile(td TemplateData) ([]byte, error) {
+	contents, err := executeTemplate("file", td)
+	if err != nil {
+		return nil, err
+	}
+
+	return addImports(contents, td)
+}
+
+// addSweeper adds the sweeper's registration to the init function of an existing sweep.go,
+// its sweep function to the end of the file and any imports the sweep function needs.
+func addSweeper(src []byte, td TemplateData) ([]byte, error) {
+	if bytes.Contains(src, []byte(fmt.Sprintf("func %s(", td.SweepFunction))) {
+		return nil, fmt.Errorf("sweep function (%s) already exists in %s", td.SweepFunction, sweepFile)
+	}
+
+	if bytes.Contains(src, []byte(fmt.Sprintf("%q", "aws_"+td.ServicePackage+"_"+td.ResourceSnake))) {
+		return nil, fmt.Errorf("sweeper (aws_%s_%s) already registered in %s", td.ServicePackage, td.ResourceSnake, sweepFile)
+	}
+
+	registration, err := executeTemplate("registration", td)
+	if err != nil {
+		return nil, err
+	}
+
+	function, err := executeTemplate("function", td)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(src), "\n")
+	start, end := -1, -1
+
+	for i, line := range lines {
+		if start == -1 {
+			if line == "func init() {" {
+				start = i
+			}
+
+			continue
+		}
+
+		if line == "}" {
+			end = i
+			break
+		}
+	}
+
+	if start == -1 || end == -1 {
+		return nil, fmt.Errorf("init function not found in %s", sweepFile)
+	}
+
+	insert := []string{strings.TrimSuffix(string(registration), "\n")}
+
+	if end > start+1 {
+		insert = append([]string{""}, insert...)
+	}
+
+	lines = append(lines[:end], append(insert, lines[end:]...)...)
+	contents := strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n" + string(function)
+
+	return addImports([]byte(contents), td)
+}
+
+// addImports adds the imports used by the sweeper to the source and formats it.
+func addImports(src []byte, td TemplateData) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, sweepFile, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %s", sweepFile, err)
+	}
+
+	for _, path := range td.imports() {
+		astutil.AddImport(fset, f, path)
+	}
+
+	var buffer bytes.Buffer
+	if err := format.Node(&buffer, fset, f); err != nil {
+		return nil, fmt.Errorf("error formatting %s: %s", sweepFile, err)
+	}
+
+	contents, err := imports.Process(sweepFile, buffer.Bytes(), &imports.Options{
+		Comments:   true,
+		FormatOnly: true,
+		TabIndent:  true,
+		TabWidth:   8,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error formatting imports of %s: %s", sweepFile, err)
+	}
+
+	return contents, nil
+}
+
+func executeTemplate(templateName string, td TemplateData) ([]byte, error) {
+	tplate, err := template.New("sweep").Parse(sweepTmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if err := tplate.ExecuteTemplate(&buffer, templateName, td); err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
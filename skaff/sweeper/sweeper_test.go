@@ -0,0 +1,155 @@
+package sweeper
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testSweepFile = `//go:build sweep
+// +build sweep
+
+package mq
+
+func init() {
+	sweep.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+		Name: "aws_mq_broker",
+		F:    sweepBrokers,
+	})
+}
+
+func sweepBrokers(region string) error {
+	return nil
+}
+`
+
+var testTemplateData = TemplateData{
+	AWSServiceName: "Amazon MQ",
+	Resource:       "Configuration",
+	ResourceSnake:  "configuration",
+	SDKPackage:     "mq",
+	Service:        "MQ",
+	ServicePackage: "mq",
+	SweepFunction:  "sweepConfigurations",
+}
+
+func TestNewSweepFile(t *testing.T) {
+	got, err := newSweepFile(testTemplateData)
+
+	if err != nil {
+		t.Fatalf("got error (%s), expected no error", err)
+	}
+
+	for _, expected := range []string{
+		"//go:build sweep\n// +build sweep\n\npackage mq\n",
+		"func init() {\n\tsweep.AddTestSweepers(\"aws_mq_configuration\", &resource.Sweeper{\n\t\tName: \"aws_mq_configuration\",\n\t\tF:    sweepConfigurations,\n\t})\n}\n",
+		"func sweepConfigurations(region string) error {\n",
+		"r := ResourceConfiguration()",
+		"err = conn.ListConfigurationsPagesWithContext(ctx, input, func(page *mq.ListConfigurationsOutput, lastPage bool) bool {",
+		"\t\"github.com/aws/aws-sdk-go/aws\"\n\t\"github.com/aws/aws-sdk-go/service/mq\"\n",
+	} {
+		if !strings.Contains(string(got), expected) {
+			t.Errorf("got %s, expected to contain %s", got, expected)
+		}
+	}
+}
+
+func TestAddSweeper(t *testing.T) {
+	got, err := addSweeper([]byte(testSweepFile), testTemplateData)
+
+	if err != nil {
+		t.Fatalf("got error (%s), expected no error", err)
+	}
+
+	for _, expected := range []string{
+		"\t\tF:    sweepBrokers,\n\t})\n\n\tsweep.AddTestSweepers(\"aws_mq_configuration\", &resource.Sweeper{\n\t\tName: \"aws_mq_configuration\",\n\t\tF:    sweepConfigurations,\n\t})\n}\n",
+		"func sweepBrokers(region string) error {\n\treturn nil\n}\n\nfunc sweepConfigurations(region string) error {\n",
+	} {
+		if !strings.Contains(string(got), expected) {
+			t.Errorf("got %s, expected to contain %s", got, expected)
+		}
+	}
+
+	if _, err := addSweeper(got, testTemplateData); err == nil {
+		t.Errorf("expected error adding sweeper twice")
+	}
+}
+
+func TestNewSweepFileAWSGoSDKV2(t *testing.T) {
+	td := testTemplateData
+	td.AWSGoSDKV2 = true
+
+	got, err := newSweepFile(td)
+
+	if err != nil {
+		t.Fatalf("got error (%s), expected no error", err)
+	}
+
+	for _, expected := range []string{
+		"paginator := mq.NewListConfigurationsPaginator(conn, input)",
+		"\t\"github.com/aws/aws-sdk-go-v2/aws\"\n\t\"github.com/aws/aws-sdk-go-v2/service/mq\"\n",
+	} {
+		if !strings.Contains(string(got), expected) {
+			t.Errorf("got %s, expected to contain %s", got, expected)
+		}
+	}
+}
+
+// TestAddSweeperTypeCheck adds a sweeper to the provider's Lambda sweep.go, which uses the
+// AWS SDK for Go v1, and type-checks the resulting package by building it.
+func TestAddSweeperTypeCheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping type-checking of the provider's Lambda package in short mode")
+	}
+
+	dir, err := filepath.Abs(filepath.Join("..", "..", "internal", "service", "lambda"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	filename := filepath.Join(dir, sweepFile)
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := addSweeper(src, TemplateData{
+		AWSServiceName: "AWS Lambda",
+		Resource:       "CodeSigningConfig",
+		ResourceSnake:  "code_signing_config",
+		SDKPackage:     "lambda",
+		Service:        "Lambda",
+		ServicePackage: "lambda",
+		SweepFunction:  "sweepCodeSigningConfigs",
+	})
+
+	if err != nil {
+		t.Fatalf("got error (%s), expected no error", err)
+	}
+
+	overlay := filepath.Join(t.TempDir(), "overlay.json")
+	b, err := json.Marshal(map[string]map[string]string{
+		"Replace": {filename: filepath.Join(filepath.Dir(overlay), sweepFile)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(overlay, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(filepath.Dir(overlay), sweepFile), got, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "build", "-tags", "sweep", "-overlay", overlay, ".")
+	cmd.Dir = dir
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("type-checking %s with added sweeper: %s\n%s", dir, err, output)
+	}
+}
@@ -0,0 +1,92 @@
+package tags
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	generateFile       = "generate.go"
+	generateFileFooter = "// ONLY generate directives and package declaration! Do not add anything else to this file."
+	tagsGenerator      = "../../generate/tags/main.go"
+)
+
+// Create adds the tags generator directive to the service package's generate.go,
+// creating the file if needed. It is run from the service package directory.
+func Create(serviceTagsMap bool, args string, force bool) error {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return fmt.Errorf("error reading working directory: %s", err)
+	}
+
+	servicePackage := filepath.Base(wd)
+
+	b, err := os.ReadFile(generateFile)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error reading file (%s): %s", generateFile, err)
+	}
+
+	contents, err := addDirective(string(b), servicePackage, directive(serviceTagsMap, args), force)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(generateFile, []byte(contents), 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", generateFile, err)
+	}
+
+	return nil
+}
+
+func directive(serviceTagsMap bool, args string) string {
+	serviceTags := "-ServiceTagsSlice"
+
+	if serviceTagsMap {
+		serviceTags = "-ServiceTagsMap"
+	}
+
+	d := fmt.Sprintf("//go:generate go run %s -ListTags %s -UpdateTags", tagsGenerator, serviceTags)
+
+	if args = strings.TrimSpace(args); args != "" {
+		d = fmt.Sprintf("%s %s", d, args)
+	}
+
+	return d
+}
+
+// addDirective returns the contents of generate.go with the tags generator directive,
+// placed after any other directives.
+func addDirective(src, servicePackage, directive string, force bool) (string, error) {
+	if src == "" {
+		return fmt.Sprintf("%s\n%s\n\npackage %s\n", directive, generateFileFooter, servicePackage), nil
+	}
+
+	lines := strings.Split(src, "\n")
+	insertAt := -1
+
+	for i, line := range lines {
+		if strings.HasPrefix(line, "//go:generate ") && strings.Contains(line, tagsGenerator) {
+			if !force {
+				return "", fmt.Errorf("tags generator directive already exists in %s and force is not set", generateFile)
+			}
+
+			lines[i] = directive
+
+			return strings.Join(lines, "\n"), nil
+		}
+
+		if strings.HasPrefix(line, "//go:generate ") {
+			insertAt = i + 1
+		}
+	}
+
+	if insertAt == -1 {
+		insertAt = 0
+	}
+
+	lines = append(lines[:insertAt], append([]string{directive}, lines[insertAt:]...)...)
+
+	return strings.Join(lines, "\n"), nil
+}
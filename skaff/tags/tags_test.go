@@ -0,0 +1,88 @@
+package tags
+
+import (
+	"testing"
+)
+
+func TestAddDirective(t *testing.T) {
+	const tagsDirective = "//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsSlice -UpdateTags"
+
+	testCases := []struct {
+		TestName string
+		Input    string
+		Force    bool
+		Expected string
+		Error    bool
+	}{
+		{
+			TestName: "new file",
+			Input:    "",
+			Expected: tagsDirective + `
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package mq
+`,
+		},
+		{
+			TestName: "other directives",
+			Input: `//go:generate go run ../../generate/listpages/main.go -ListOps=ListBrokers
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package mq
+`,
+			Expected: `//go:generate go run ../../generate/listpages/main.go -ListOps=ListBrokers
+` + tagsDirective + `
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package mq
+`,
+		},
+		{
+			TestName: "existing",
+			Input: `//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package mq
+`,
+			Error: true,
+		},
+		{
+			TestName: "existing force",
+			Input: `//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package mq
+`,
+			Force: true,
+			Expected: tagsDirective + `
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package mq
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, err := addDirective(testCase.Input, "mq", tagsDirective, testCase.Force)
+
+			if err != nil && !testCase.Error {
+				t.Errorf("got error (%s), expected no error", err)
+			}
+
+			if err == nil && testCase.Error {
+				t.Errorf("got (%s) and no error, expected error", got)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDirective(t *testing.T) {
+	if got, expected := directive(true, " -ListTagsOp=ListTags "), "//go:generate go run ../../generate/tags/main.go -ListTags -ServiceTagsMap -UpdateTags -ListTagsOp=ListTags"; got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+}